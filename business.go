@@ -0,0 +1,67 @@
+package dt
+
+import "time"
+
+// A BusinessCalendar decides which dates are business days. The zero value
+// treats Monday through Friday as business days and has no holidays.
+type BusinessCalendar struct {
+	// Weekend lists the days of the week that are not business days.
+	// If nil, Saturday and Sunday are used.
+	Weekend []time.Weekday
+	// Holidays reports whether a date is a holiday. It may be nil.
+	Holidays func(Date) bool
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday.
+func (c BusinessCalendar) IsBusinessDay(d Date) bool {
+	wd := d.Weekday()
+	weekend := c.Weekend
+	if weekend == nil {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	for _, w := range weekend {
+		if w == wd {
+			return false
+		}
+	}
+	return c.Holidays == nil || !c.Holidays(d)
+}
+
+// AddBusinessDays returns the n-th business day after d, or before d for
+// negative n. For n == 0 it returns d, even if d is not a business day.
+// If the calendar has no business days it returns an invalid Date.
+func (c BusinessCalendar) AddBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	// Give up after a year without business days, which can only happen
+	// when every weekday is a weekend day or a holiday.
+	for misses := 0; n > 0; {
+		d = d.AddDays(step)
+		if c.IsBusinessDay(d) {
+			n, misses = n-1, 0
+			continue
+		}
+		if misses++; misses > 366 {
+			return Date{}
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween returns the number of business days from start up to,
+// but not including, end. It is negative if end is before start.
+func (c BusinessCalendar) BusinessDaysBetween(start, end Date) int {
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+	n := 0
+	for d := start; d.Before(end); d = d.AddDays(1) {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return sign * n
+}
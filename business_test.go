@@ -0,0 +1,60 @@
+package dt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessCalendar(t *testing.T) {
+	christmas := func(d Date) bool { return d.Month == time.December && (d.Day == 25 || d.Day == 26) }
+	std := BusinessCalendar{Holidays: christmas}
+	gulf := BusinessCalendar{Weekend: []time.Weekday{time.Friday, time.Saturday}}
+
+	fri := Date{2024, time.May, 17, true}
+	for _, tt := range []struct {
+		name string
+		c    BusinessCalendar
+		d    Date
+		want bool
+	}{
+		{"Friday", std, fri, true},
+		{"Saturday", std, fri.AddDays(1), false},
+		{"Holiday", std, Date{2024, time.December, 25, true}, false},
+		{"Friday with Friday weekend", gulf, fri, false},
+		{"Sunday with Friday weekend", gulf, fri.AddDays(2), true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.IsBusinessDay(tt.d); got != tt.want {
+				t.Errorf("IsBusinessDay(%v) = %t, want %t", tt.d, got, tt.want)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		c    BusinessCalendar
+		d    Date
+		n    int
+		want Date
+	}{
+		{"Over a weekend", std, fri, 1, Date{2024, time.May, 20, true}},
+		{"Backwards over a weekend", std, fri.AddDays(3), -2, Date{2024, time.May, 16, true}},
+		{"Zero days", std, fri.AddDays(1), 0, fri.AddDays(1)},
+		{"Over holidays", std, Date{2024, time.December, 24, true}, 1, Date{2024, time.December, 27, true}},
+		{"No business days", BusinessCalendar{Holidays: func(Date) bool { return true }}, fri, 1, Date{}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.AddBusinessDays(tt.d, tt.n); got != tt.want {
+				t.Errorf("AddBusinessDays(%v, %d) = %v, want %v", tt.d, tt.n, got, tt.want)
+			}
+		})
+	}
+
+	start, end := Date{2024, time.December, 1, true}, Date{2025, time.January, 1, true}
+	if got := std.BusinessDaysBetween(start, end); got != 20 {
+		t.Errorf("BusinessDaysBetween = %d, want 20", got)
+	}
+	if got := std.BusinessDaysBetween(end, start); got != -20 {
+		t.Errorf("BusinessDaysBetween reversed = %d, want -20", got)
+	}
+}
@@ -0,0 +1,124 @@
+package dt
+
+import "time"
+
+// IsLeapYear reports whether year is a leap year in the proleptic Gregorian calendar.
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func DaysInMonth(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if IsLeapYear(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+	return 31
+}
+
+// IsLeapYear reports whether d falls in a leap year.
+func (d Date) IsLeapYear() bool {
+	return IsLeapYear(d.Year)
+}
+
+// DaysInMonth returns the number of days in d's month.
+func (d Date) DaysInMonth() int {
+	return DaysInMonth(d.Year, d.Month)
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return weekdayFromDays(d.UnixDays())
+}
+
+// Quarter returns the quarter of the year d falls in, in range [1-4].
+func (d Date) Quarter() int {
+	return (int(d.Month)-1)/3 + 1
+}
+
+// StartOfWeek returns the first day of the week containing d, where weeks
+// start on firstDay.
+func (d Date) StartOfWeek(firstDay time.Weekday) Date {
+	return d.AddDays(-((int(d.Weekday()) - int(firstDay) + 7) % 7))
+}
+
+// EndOfWeek returns the last day of the week containing d, where weeks
+// start on firstDay.
+func (d Date) EndOfWeek(firstDay time.Weekday) Date {
+	return d.StartOfWeek(firstDay).AddDays(6)
+}
+
+// StartOfMonth returns the first day of d's month.
+func (d Date) StartOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: 1, Valid: true}
+}
+
+// EndOfMonth returns the last day of d's month.
+func (d Date) EndOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: d.DaysInMonth(), Valid: true}
+}
+
+// StartOfQuarter returns the first day of d's quarter.
+func (d Date) StartOfQuarter() Date {
+	return Date{Year: d.Year, Month: time.Month((d.Quarter()-1)*3 + 1), Day: 1, Valid: true}
+}
+
+// EndOfQuarter returns the last day of d's quarter.
+func (d Date) EndOfQuarter() Date {
+	m := time.Month(d.Quarter() * 3)
+	return Date{Year: d.Year, Month: m, Day: DaysInMonth(d.Year, m), Valid: true}
+}
+
+// StartOfYear returns January 1st of d's year.
+func (d Date) StartOfYear() Date {
+	return Date{Year: d.Year, Month: time.January, Day: 1, Valid: true}
+}
+
+// EndOfYear returns December 31st of d's year.
+func (d Date) EndOfYear() Date {
+	return Date{Year: d.Year, Month: time.December, Day: 31, Valid: true}
+}
+
+// NextWeekday returns the first date strictly after d that falls on wd.
+func (d Date) NextWeekday(wd time.Weekday) Date {
+	n := (int(wd) - int(d.Weekday()) + 7) % 7
+	if n == 0 {
+		n = 7
+	}
+	return d.AddDays(n)
+}
+
+// PreviousWeekday returns the last date strictly before d that falls on wd.
+func (d Date) PreviousWeekday(wd time.Weekday) Date {
+	n := (int(d.Weekday()) - int(wd) + 7) % 7
+	if n == 0 {
+		n = 7
+	}
+	return d.AddDays(-n)
+}
+
+// NthWeekdayOfMonth returns the n-th occurrence of wd in d's month.
+// Negative n counts from the end of the month, so -1 is the last occurrence.
+// If the month has no such occurrence, or n is zero, the returned Date is not Valid.
+func (d Date) NthWeekdayOfMonth(n int, wd time.Weekday) Date {
+	var r Date
+	switch {
+	case n > 0:
+		first := d.StartOfMonth()
+		r = first.AddDays((int(wd)-int(first.Weekday())+7)%7 + (n-1)*7)
+	case n < 0:
+		last := d.EndOfMonth()
+		r = last.AddDays(-((int(last.Weekday())-int(wd)+7)%7 + (-n-1)*7))
+	default:
+		return Date{}
+	}
+	if r.Year != d.Year || r.Month != d.Month {
+		return Date{}
+	}
+	return r
+}
@@ -0,0 +1,74 @@
+// Package calendar converts dt.Date values to and from non-Gregorian calendars:
+// the proleptic Julian calendar, the tabular Islamic (Hijri) calendar and the
+// Hebrew calendar.
+//
+// Every calendar implements the Calendar interface and has its own date type,
+// such as HebrewDate, which supports arithmetic and formatting with the
+// calendar's month and weekday names.
+package calendar
+
+import (
+	"fmt"
+
+	"github.com/ribice/dt"
+)
+
+// A Calendar converts dates between a calendar system and dt.Date.
+// Months are numbered from 1, in the order documented by each calendar.
+type Calendar interface {
+	// Name returns the name of the calendar, e.g. "Julian".
+	Name() string
+	// FromDate returns the year, month and day of d in the calendar.
+	FromDate(d dt.Date) (year, month, day int)
+	// ToDate returns the dt.Date of the given year, month and day in the calendar.
+	// It returns an error if they do not form a date of the calendar.
+	ToDate(year, month, day int) (dt.Date, error)
+	// MonthsInYear returns the number of months in year.
+	MonthsInYear(year int) int
+	// DaysInMonth returns the number of days in the given month of year.
+	DaysInMonth(year, month int) int
+	// MonthName returns the name of the given month of year.
+	MonthName(year, month int) string
+}
+
+// checkDate returns an error if year, month and day do not form a date of c.
+func checkDate(c Calendar, year, month, day int) error {
+	if month < 1 || month > c.MonthsInYear(year) {
+		return &dt.RangeError{Field: "month", Value: month}
+	}
+	if day < 1 || day > c.DaysInMonth(year, month) {
+		return &dt.RangeError{Field: "day", Value: day}
+	}
+	return nil
+}
+
+// clampDay returns day limited to the length of the given month of year in c.
+func clampDay(c Calendar, year, month, day int) int {
+	if n := c.DaysInMonth(year, month); day > n {
+		return n
+	}
+	return day
+}
+
+// format returns the date in the form "2 Av 5784 AM".
+func format(c Calendar, year, month, day int, era string) string {
+	s := fmt.Sprintf("%d %s %d", day, c.MonthName(year, month), year)
+	if era != "" {
+		s += " " + era
+	}
+	return s
+}
+
+// floorDiv returns a/b rounded towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// mod returns a modulo b, with the sign of b.
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
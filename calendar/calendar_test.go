@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ribice/dt"
+)
+
+func TestRoundTrip(t *testing.T) {
+	start := dt.Date{Year: 1, Month: 1, Day: 1, Valid: true}.UnixDays()
+	end := dt.Date{Year: 3000, Month: 12, Day: 31, Valid: true}.UnixDays()
+	for _, c := range []Calendar{Julian{}, Islamic{}, Hebrew{}} {
+		t.Run(c.Name(), func(t *testing.T) {
+			py, pm, pd := c.FromDate(dt.DateFromUnixDays(start - 1))
+			for n := start; n <= end; n++ {
+				d := dt.DateFromUnixDays(n)
+				y, m, dd := c.FromDate(d)
+				got, err := c.ToDate(y, m, dd)
+				if err != nil || got != d {
+					t.Fatalf("%v: round trip through %d-%d-%d gave %v (error %v)", d, y, m, dd, got, err)
+				}
+				// Consecutive days either advance the day, or start a new month.
+				if !(y == py && m == pm && dd == pd+1) && dd != 1 {
+					t.Fatalf("%v: %d-%d-%d does not follow %d-%d-%d", d, y, m, dd, py, pm, pd)
+				}
+				py, pm, pd = y, m, dd
+			}
+		})
+	}
+}
+
+func TestCheckDate(t *testing.T) {
+	var rerr *dt.RangeError
+	for _, tt := range []struct {
+		name  string
+		c     Calendar
+		y     int
+		m, d  int
+		field string
+	}{
+		{"Julian leap day in 1900", Julian{}, 1900, 2, 29, ""},
+		{"Julian February 30th", Julian{}, 1900, 2, 30, "day"},
+		{"Islamic 13th month", Islamic{}, 1445, 13, 1, "month"},
+		{"Islamic 30 Dhu al-Hijjah in leap year", Islamic{}, 1445, 12, 30, ""},
+		{"Islamic 30 Dhu al-Hijjah in common year", Islamic{}, 1444, 12, 30, "day"},
+		{"Hebrew Adar II in leap year", Hebrew{}, 5784, AdarII, 29, ""},
+		{"Hebrew Adar II in common year", Hebrew{}, 5785, AdarII, 1, "month"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.c.ToDate(tt.y, tt.m, tt.d)
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.As(err, &rerr) || rerr.Field != tt.field {
+				t.Errorf("expected %s RangeError, got %v", tt.field, err)
+			}
+		})
+	}
+}
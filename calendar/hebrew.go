@@ -0,0 +1,266 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// Hebrew months, numbered from Nisan as in the Bible. The civil year starts on Tishrei.
+const (
+	Nisan      = 1
+	Iyyar      = 2
+	Sivan      = 3
+	Tammuz     = 4
+	Av         = 5
+	Elul       = 6
+	Tishrei    = 7
+	Marheshvan = 8
+	Kislev     = 9
+	Tevet      = 10
+	Shevat     = 11
+	Adar       = 12 // Adar I in leap years.
+	AdarII     = 13 // Only in leap years.
+)
+
+// hebrewEpoch is the Rata Die of 1 Tishrei AM 1, October 7th 3761 BC in the Julian calendar.
+const hebrewEpoch = -1373427
+
+// rataDieOffset converts between Unix days and Rata Die, where day 1 is 0001-01-01.
+const rataDieOffset = 719163
+
+var hebrewMonths = [...]string{
+	"Nisan", "Iyyar", "Sivan", "Tammuz", "Av", "Elul",
+	"Tishrei", "Marheshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II",
+}
+
+var hebrewWeekdays = [...]string{
+	"Yom Rishon", "Yom Sheni", "Yom Shlishi", "Yom Revi'i", "Yom Hamishi", "Yom Shishi", "Shabbat",
+}
+
+// Hebrew is the arithmetic Hebrew calendar, a lunisolar calendar with 12
+// months in common years and 13 in leap years. Years are counted Anno Mundi
+// and start on 1 Tishrei. Months are numbered Nisan = 1 through Adar II = 13.
+type Hebrew struct{}
+
+// Name returns "Hebrew".
+func (Hebrew) Name() string { return "Hebrew" }
+
+// IsLeapYear reports whether year has a thirteenth month, Adar II.
+func (Hebrew) IsLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// MonthsInYear returns 13 in leap years and 12 otherwise.
+func (c Hebrew) MonthsInYear(year int) int {
+	if c.IsLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// DaysInYear returns the number of days in year, which is one of 353, 354,
+// 355, 383, 384 or 385.
+func (c Hebrew) DaysInYear(year int) int {
+	return c.newYear(year+1) - c.newYear(year)
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c Hebrew) DaysInMonth(year, month int) int {
+	switch month {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !c.IsLeapYear(year) {
+			return 29
+		}
+	case Marheshvan:
+		if n := c.DaysInYear(year) % 10; n != 5 {
+			return 29
+		}
+	case Kislev:
+		if n := c.DaysInYear(year) % 10; n == 3 {
+			return 29
+		}
+	}
+	return 30
+}
+
+// MonthName returns the transliterated name of the given month of year.
+// In leap years, Adar is named Adar I.
+func (c Hebrew) MonthName(year, month int) string {
+	if month < 1 || month > 13 {
+		return fmt.Sprintf("%%!Month(%d)", month)
+	}
+	if month == Adar && c.IsLeapYear(year) {
+		return "Adar I"
+	}
+	return hebrewMonths[month-1]
+}
+
+// FromDate returns the year, month and day of d in the Hebrew calendar.
+func (c Hebrew) FromDate(d dt.Date) (year, month, day int) {
+	rd := d.UnixDays() + rataDieOffset
+	year = floorDiv((rd-hebrewEpoch)*98496, 35975351)
+	for c.newYear(year+1) <= rd {
+		year++
+	}
+	month = Tishrei
+	if rd >= c.rataDie(year, Nisan, 1) {
+		month = Nisan
+	}
+	for rd > c.rataDie(year, month, c.DaysInMonth(year, month)) {
+		month++
+	}
+	return year, month, rd - c.rataDie(year, month, 1) + 1
+}
+
+// ToDate returns the dt.Date of the given Hebrew year, month and day.
+func (c Hebrew) ToDate(year, month, day int) (dt.Date, error) {
+	if err := checkDate(c, year, month, day); err != nil {
+		return dt.Date{}, err
+	}
+	return dt.DateFromUnixDays(c.rataDie(year, month, day) - rataDieOffset), nil
+}
+
+// elapsedDays returns the number of days from the epoch to the molad of
+// Tishrei of year, postponed if it falls on Sunday, Wednesday or Friday.
+func (Hebrew) elapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+// newYear returns the Rata Die of 1 Tishrei of year.
+func (c Hebrew) newYear(year int) int {
+	ny0, ny1, ny2 := c.elapsedDays(year-1), c.elapsedDays(year), c.elapsedDays(year+1)
+	delay := 0
+	switch {
+	case ny2-ny1 == 356:
+		delay = 2
+	case ny1-ny0 == 382:
+		delay = 1
+	}
+	return hebrewEpoch + ny1 + delay
+}
+
+// rataDie returns the Rata Die of the given date.
+func (c Hebrew) rataDie(year, month, day int) int {
+	rd := c.newYear(year) + day - 1
+	if month < Tishrei {
+		for m := Tishrei; m <= c.MonthsInYear(year); m++ {
+			rd += c.DaysInMonth(year, m)
+		}
+		for m := Nisan; m < month; m++ {
+			rd += c.DaysInMonth(year, m)
+		}
+		return rd
+	}
+	for m := Tishrei; m < month; m++ {
+		rd += c.DaysInMonth(year, m)
+	}
+	return rd
+}
+
+// monthIndex returns the position of month in year, counting from Tishrei = 0.
+func (c Hebrew) monthIndex(year, month int) int {
+	n := c.MonthsInYear(year)
+	return (month - Tishrei + n) % n
+}
+
+// monthAt is the inverse of monthIndex.
+func (c Hebrew) monthAt(year, idx int) int {
+	return (idx+Tishrei-1)%c.MonthsInYear(year) + 1
+}
+
+// A HebrewDate is a date in the Hebrew calendar.
+type HebrewDate struct {
+	Year  int // Year Anno Mundi (AM).
+	Month int // Month of the year, Nisan = 1, see the month constants.
+	Day   int // Day of the month, starting at 1.
+}
+
+// HebrewDateOf returns the Hebrew calendar date of d.
+func HebrewDateOf(d dt.Date) HebrewDate {
+	y, m, dd := Hebrew{}.FromDate(d)
+	return HebrewDate{Year: y, Month: m, Day: dd}
+}
+
+// ToDate returns the Gregorian dt.Date of hd.
+func (hd HebrewDate) ToDate() (dt.Date, error) {
+	return Hebrew{}.ToDate(hd.Year, hd.Month, hd.Day)
+}
+
+// IsValid reports whether hd is a date of the Hebrew calendar.
+func (hd HebrewDate) IsValid() bool {
+	return checkDate(Hebrew{}, hd.Year, hd.Month, hd.Day) == nil
+}
+
+// AddDays returns the date n days after hd.
+func (hd HebrewDate) AddDays(n int) HebrewDate {
+	return HebrewDateOf(dt.DateFromUnixDays(Hebrew{}.rataDie(hd.Year, hd.Month, hd.Day) - rataDieOffset + n))
+}
+
+// AddMonths returns the date n months after hd, following the order of months
+// in the civil year, which starts on Tishrei. Adar in a common year is followed
+// by Adar I in a leap year, and Adar II is followed by Adar in a common year.
+// If the day does not exist in the resulting month, the last day of the month is used.
+func (hd HebrewDate) AddMonths(n int) HebrewDate {
+	c := Hebrew{}
+	y, idx := hd.Year, c.monthIndex(hd.Year, hd.Month)
+	for ; n > 0; n-- {
+		if idx++; idx == c.MonthsInYear(y) {
+			y, idx = y+1, 0
+		}
+	}
+	for ; n < 0; n++ {
+		if idx--; idx < 0 {
+			y--
+			idx = c.MonthsInYear(y) - 1
+		}
+	}
+	m := c.monthAt(y, idx)
+	return HebrewDate{Year: y, Month: m, Day: clampDay(c, y, m, hd.Day)}
+}
+
+// AddYears returns the date n years after hd. Adar II in a year that is
+// not a leap year becomes Adar, and days are limited to the length of the month.
+func (hd HebrewDate) AddYears(n int) HebrewDate {
+	c := Hebrew{}
+	y, m := hd.Year+n, hd.Month
+	if m == AdarII && !c.IsLeapYear(y) {
+		m = Adar
+	}
+	return HebrewDate{Year: y, Month: m, Day: clampDay(c, y, m, hd.Day)}
+}
+
+// Weekday returns the day of the week of hd.
+func (hd HebrewDate) Weekday() time.Weekday {
+	// Rata Die 1 is a Monday.
+	return time.Weekday(mod(Hebrew{}.rataDie(hd.Year, hd.Month, hd.Day), 7))
+}
+
+// WeekdayName returns the transliterated Hebrew name of hd's day of the week.
+func (hd HebrewDate) WeekdayName() string {
+	return hebrewWeekdays[hd.Weekday()]
+}
+
+// MonthName returns the transliterated name of hd's month.
+func (hd HebrewDate) MonthName() string {
+	return Hebrew{}.MonthName(hd.Year, hd.Month)
+}
+
+// String returns hd in the form YYYY-MM-DD, with months numbered from Nisan.
+func (hd HebrewDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", hd.Year, hd.Month, hd.Day)
+}
+
+// Format returns hd in the long form, e.g. "1 Tishrei 5785 AM".
+func (hd HebrewDate) Format() string {
+	return format(Hebrew{}, hd.Year, hd.Month, hd.Day, "AM")
+}
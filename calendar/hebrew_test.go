@@ -0,0 +1,97 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func TestHebrewDateOf(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		d    dt.Date
+		want HebrewDate
+	}{
+		{"Rosh Hashanah 5785", dt.Date{Year: 2024, Month: 10, Day: 3, Valid: true}, HebrewDate{5785, Tishrei, 1}},
+		{"Passover 5784", dt.Date{Year: 2024, Month: 4, Day: 23, Valid: true}, HebrewDate{5784, Nisan, 15}},
+		{"Purim in a leap year", dt.Date{Year: 2024, Month: 3, Day: 24, Valid: true}, HebrewDate{5784, AdarII, 14}},
+		{"Purim in a common year", dt.Date{Year: 2023, Month: 3, Day: 7, Valid: true}, HebrewDate{5783, Adar, 14}},
+		{"Hanukkah 5784", dt.Date{Year: 2023, Month: 12, Day: 8, Valid: true}, HebrewDate{5784, Kislev, 25}},
+		{"Yom Kippur 5760", dt.Date{Year: 1999, Month: 9, Day: 20, Valid: true}, HebrewDate{5760, Tishrei, 10}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HebrewDateOf(tt.d); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			if got, err := tt.want.ToDate(); err != nil || got != tt.d {
+				t.Errorf("expected %v, got %v (error %v)", tt.d, got, err)
+			}
+		})
+	}
+}
+
+func TestHebrewYears(t *testing.T) {
+	c := Hebrew{}
+	for _, tt := range []struct {
+		year int
+		days int
+		leap bool
+	}{
+		{5783, 355, false},
+		{5784, 383, true},
+		{5785, 355, false},
+		{5786, 354, false},
+		{5787, 385, true},
+	} {
+		if got := c.DaysInYear(tt.year); got != tt.days {
+			t.Errorf("DaysInYear(%d): got %d, want %d", tt.year, got, tt.days)
+		}
+		if got := c.IsLeapYear(tt.year); got != tt.leap {
+			t.Errorf("IsLeapYear(%d): got %t, want %t", tt.year, got, tt.leap)
+		}
+	}
+}
+
+func TestHebrewDateArithmetic(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		got  HebrewDate
+		want HebrewDate
+	}{
+		{"Add days across new year", HebrewDate{5784, Elul, 29}.AddDays(1), HebrewDate{5785, Tishrei, 1}},
+		{"Add months across new year", HebrewDate{5784, Elul, 10}.AddMonths(1), HebrewDate{5785, Tishrei, 10}},
+		{"Add months into Adar I", HebrewDate{5784, Shevat, 30}.AddMonths(1), HebrewDate{5784, Adar, 30}},
+		{"Add months into Adar II", HebrewDate{5784, Adar, 30}.AddMonths(1), HebrewDate{5784, AdarII, 29}},
+		{"Add months past Adar II", HebrewDate{5784, AdarII, 1}.AddMonths(1), HebrewDate{5784, Nisan, 1}},
+		{"Add months past common Adar", HebrewDate{5785, Adar, 1}.AddMonths(1), HebrewDate{5785, Nisan, 1}},
+		{"Subtract months across new year", HebrewDate{5785, Tishrei, 1}.AddMonths(-2), HebrewDate{5784, Av, 1}},
+		{"Add years from Adar II", HebrewDate{5784, AdarII, 14}.AddYears(1), HebrewDate{5785, Adar, 14}},
+		{"Add years to short Kislev", HebrewDate{5784, Kislev, 30}.AddYears(2), HebrewDate{5786, Kislev, 30}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestHebrewDateFormat(t *testing.T) {
+	hd := HebrewDate{5785, Tishrei, 1}
+	if got := hd.Weekday(); got != time.Thursday {
+		t.Errorf("expected %v, got %v", time.Thursday, got)
+	}
+	if got, want := hd.WeekdayName(), "Yom Hamishi"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := hd.Format(), "1 Tishrei 5785 AM"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := (HebrewDate{5784, Adar, 1}).MonthName(), "Adar I"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := (HebrewDate{5785, Adar, 1}).MonthName(), "Adar"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
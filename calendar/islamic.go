@@ -0,0 +1,141 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// islamicEpoch is the Julian Day Number of 1 Muharram 1 AH, July 16th 622 in the Julian calendar.
+const islamicEpoch = 1948440
+
+var islamicMonths = [...]string{
+	"Muharram", "Safar", "Rabi' al-Awwal", "Rabi' al-Thani", "Jumada al-Ula", "Jumada al-Akhirah",
+	"Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qa'dah", "Dhu al-Hijjah",
+}
+
+var islamicWeekdays = [...]string{
+	"al-Ahad", "al-Ithnayn", "ath-Thulatha'", "al-Arba'a", "al-Khamis", "al-Jumu'ah", "as-Sabt",
+}
+
+// Islamic is the tabular Islamic (Hijri) calendar with the civil epoch and
+// leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each 30-year cycle.
+// It approximates the observational calendar, which may differ by a day or two.
+// Months are numbered Muharram = 1 through Dhu al-Hijjah = 12.
+type Islamic struct{}
+
+// Name returns "Islamic".
+func (Islamic) Name() string { return "Islamic" }
+
+// IsLeapYear reports whether year has 355 rather than 354 days.
+func (Islamic) IsLeapYear(year int) bool {
+	return mod(14+11*year, 30) < 11
+}
+
+// MonthsInYear returns 12.
+func (Islamic) MonthsInYear(int) int { return 12 }
+
+// DaysInMonth returns the number of days in the given month of year. Odd
+// months have 30 days and even months 29, except Dhu al-Hijjah in leap years.
+func (c Islamic) DaysInMonth(year, month int) int {
+	if month%2 == 1 || (month == 12 && c.IsLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
+
+// MonthName returns the transliterated name of month.
+func (Islamic) MonthName(_, month int) string {
+	if month < 1 || month > 12 {
+		return fmt.Sprintf("%%!Month(%d)", month)
+	}
+	return islamicMonths[month-1]
+}
+
+// FromDate returns the year, month and day of d in the Islamic calendar.
+func (c Islamic) FromDate(d dt.Date) (year, month, day int) {
+	jdn := d.JulianDayNumber()
+	year = floorDiv(30*(jdn-islamicEpoch)+10646, 10631)
+	month = min(12, floorDiv(2*(jdn-c.julianDayNumber(year, 1, 1)), 59)+1)
+	day = jdn - c.julianDayNumber(year, month, 1) + 1
+	return year, month, day
+}
+
+// ToDate returns the dt.Date of the given Islamic year, month and day.
+func (c Islamic) ToDate(year, month, day int) (dt.Date, error) {
+	if err := checkDate(c, year, month, day); err != nil {
+		return dt.Date{}, err
+	}
+	return dt.DateFromJulianDayNumber(c.julianDayNumber(year, month, day)), nil
+}
+
+func (Islamic) julianDayNumber(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + floorDiv(3+11*year, 30) + islamicEpoch - 1
+}
+
+// An IslamicDate is a date in the tabular Islamic calendar.
+type IslamicDate struct {
+	Year  int // Year after the Hijra (AH).
+	Month int // Month of the year, Muharram = 1.
+	Day   int // Day of the month, starting at 1.
+}
+
+// IslamicDateOf returns the Islamic calendar date of d.
+func IslamicDateOf(d dt.Date) IslamicDate {
+	y, m, dd := Islamic{}.FromDate(d)
+	return IslamicDate{Year: y, Month: m, Day: dd}
+}
+
+// ToDate returns the Gregorian dt.Date of id.
+func (id IslamicDate) ToDate() (dt.Date, error) {
+	return Islamic{}.ToDate(id.Year, id.Month, id.Day)
+}
+
+// IsValid reports whether id is a date of the Islamic calendar.
+func (id IslamicDate) IsValid() bool {
+	return checkDate(Islamic{}, id.Year, id.Month, id.Day) == nil
+}
+
+// AddDays returns the date n days after id.
+func (id IslamicDate) AddDays(n int) IslamicDate {
+	return IslamicDateOf(dt.DateFromJulianDayNumber(Islamic{}.julianDayNumber(id.Year, id.Month, id.Day) + n))
+}
+
+// AddMonths returns the date n months after id. If the day does not exist in
+// the resulting month, the last day of the month is used.
+func (id IslamicDate) AddMonths(n int) IslamicDate {
+	m := id.Year*12 + id.Month - 1 + n
+	y, mm := floorDiv(m, 12), mod(m, 12)+1
+	return IslamicDate{Year: y, Month: mm, Day: clampDay(Islamic{}, y, mm, id.Day)}
+}
+
+// AddYears returns the date n years after id.
+func (id IslamicDate) AddYears(n int) IslamicDate {
+	return id.AddMonths(12 * n)
+}
+
+// Weekday returns the day of the week of id.
+func (id IslamicDate) Weekday() time.Weekday {
+	return time.Weekday(mod(Islamic{}.julianDayNumber(id.Year, id.Month, id.Day)+1, 7))
+}
+
+// WeekdayName returns the transliterated Arabic name of id's day of the week.
+func (id IslamicDate) WeekdayName() string {
+	return islamicWeekdays[id.Weekday()]
+}
+
+// MonthName returns the transliterated name of id's month.
+func (id IslamicDate) MonthName() string {
+	return Islamic{}.MonthName(id.Year, id.Month)
+}
+
+// String returns id in the form YYYY-MM-DD.
+func (id IslamicDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", id.Year, id.Month, id.Day)
+}
+
+// Format returns id in the long form, e.g. "1 Ramadan 1445 AH".
+func (id IslamicDate) Format() string {
+	return format(Islamic{}, id.Year, id.Month, id.Day, "AH")
+}
@@ -0,0 +1,73 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func TestIslamicDateOf(t *testing.T) {
+	for _, tt := range []struct {
+		d    dt.Date
+		want IslamicDate
+	}{
+		{dt.Date{Year: 622, Month: 7, Day: 19, Valid: true}, IslamicDate{1, 1, 1}},
+		{dt.Date{Year: 2023, Month: 7, Day: 19, Valid: true}, IslamicDate{1445, 1, 1}},
+		{dt.Date{Year: 2024, Month: 3, Day: 11, Valid: true}, IslamicDate{1445, 9, 1}},
+		{dt.Date{Year: 2024, Month: 7, Day: 7, Valid: true}, IslamicDate{1445, 12, 30}},
+	} {
+		if got := IslamicDateOf(tt.d); got != tt.want {
+			t.Errorf("IslamicDateOf(%v): got %v, want %v", tt.d, got, tt.want)
+		}
+		if got, err := tt.want.ToDate(); err != nil || got != tt.d {
+			t.Errorf("%v.ToDate(): got %v, want %v (error %v)", tt.want, got, tt.d, err)
+		}
+	}
+}
+
+func TestIslamicLeapYears(t *testing.T) {
+	c := Islamic{}
+	leap := map[int]bool{2: true, 5: true, 7: true, 10: true, 13: true, 16: true, 18: true, 21: true, 24: true, 26: true, 29: true}
+	for y := 1; y <= 30; y++ {
+		if got := c.IsLeapYear(y + 1410); got != leap[y] {
+			t.Errorf("IsLeapYear(%d): got %t, want %t", y+1410, got, leap[y])
+		}
+	}
+}
+
+func TestIslamicDateArithmetic(t *testing.T) {
+	id := IslamicDate{1445, 12, 30}
+	for _, tt := range []struct {
+		name string
+		got  IslamicDate
+		want IslamicDate
+	}{
+		{"Add days", id.AddDays(1), IslamicDate{1446, 1, 1}},
+		{"Add months", id.AddMonths(2), IslamicDate{1446, 2, 29}},
+		{"Add years", id.AddYears(1), IslamicDate{1446, 12, 29}},
+		{"Subtract months", id.AddMonths(-3), IslamicDate{1445, 9, 30}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestIslamicDateFormat(t *testing.T) {
+	id := IslamicDate{1445, 9, 1}
+	if got := id.Weekday(); got != time.Monday {
+		t.Errorf("expected %v, got %v", time.Monday, got)
+	}
+	if got, want := id.WeekdayName(), "al-Ithnayn"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := id.String(), "1445-09-01"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := id.Format(), "1 Ramadan 1445 AH"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,127 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// Julian is the proleptic Julian calendar, in which every fourth year is a leap year.
+// Years use astronomical numbering, so year 0 is 1 BC. Months are numbered
+// January = 1 through December = 12.
+type Julian struct{}
+
+// Name returns "Julian".
+func (Julian) Name() string { return "Julian" }
+
+// IsLeapYear reports whether year is a leap year in the Julian calendar.
+func (Julian) IsLeapYear(year int) bool {
+	return mod(year, 4) == 0
+}
+
+// MonthsInYear returns 12.
+func (Julian) MonthsInYear(int) int { return 12 }
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c Julian) DaysInMonth(year, month int) int {
+	if month == 2 && c.IsLeapYear(year) {
+		return 29
+	}
+	return dt.DaysInMonth(2001, time.Month(month))
+}
+
+// MonthName returns the English name of month.
+func (Julian) MonthName(_, month int) string {
+	return time.Month(month).String()
+}
+
+// FromDate returns the year, month and day of d in the Julian calendar.
+func (Julian) FromDate(d dt.Date) (year, month, day int) {
+	c := d.JulianDayNumber() + 32082
+	dd := floorDiv(4*c+3, 1461)
+	e := c - floorDiv(1461*dd, 4)
+	m := (5*e + 2) / 153
+	day = e - (153*m+2)/5 + 1
+	month = m + 3 - 12*(m/10)
+	year = dd - 4800 + m/10
+	return year, month, day
+}
+
+// ToDate returns the dt.Date of the given Julian year, month and day.
+func (c Julian) ToDate(year, month, day int) (dt.Date, error) {
+	if err := checkDate(c, year, month, day); err != nil {
+		return dt.Date{}, err
+	}
+	return dt.DateFromJulianDayNumber(c.julianDayNumber(year, month, day)), nil
+}
+
+func (Julian) julianDayNumber(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + floorDiv(y, 4) - 32083
+}
+
+// A JulianDate is a date in the proleptic Julian calendar.
+type JulianDate struct {
+	Year  int // Astronomical year, where 0 is 1 BC.
+	Month int // Month of the year, January = 1.
+	Day   int // Day of the month, starting at 1.
+}
+
+// JulianDateOf returns the Julian calendar date of d.
+func JulianDateOf(d dt.Date) JulianDate {
+	y, m, dd := Julian{}.FromDate(d)
+	return JulianDate{Year: y, Month: m, Day: dd}
+}
+
+// ToDate returns the Gregorian dt.Date of jd.
+func (jd JulianDate) ToDate() (dt.Date, error) {
+	return Julian{}.ToDate(jd.Year, jd.Month, jd.Day)
+}
+
+// IsValid reports whether jd is a date of the Julian calendar.
+func (jd JulianDate) IsValid() bool {
+	return checkDate(Julian{}, jd.Year, jd.Month, jd.Day) == nil
+}
+
+// AddDays returns the date n days after jd.
+func (jd JulianDate) AddDays(n int) JulianDate {
+	d := dt.DateFromJulianDayNumber(Julian{}.julianDayNumber(jd.Year, jd.Month, jd.Day) + n)
+	return JulianDateOf(d)
+}
+
+// AddMonths returns the date n months after jd. If the day does not exist in
+// the resulting month, the last day of the month is used.
+func (jd JulianDate) AddMonths(n int) JulianDate {
+	m := jd.Year*12 + jd.Month - 1 + n
+	y, mm := floorDiv(m, 12), mod(m, 12)+1
+	return JulianDate{Year: y, Month: mm, Day: clampDay(Julian{}, y, mm, jd.Day)}
+}
+
+// AddYears returns the date n years after jd. February 29th becomes
+// February 28th in common years.
+func (jd JulianDate) AddYears(n int) JulianDate {
+	return jd.AddMonths(12 * n)
+}
+
+// Weekday returns the day of the week of jd.
+func (jd JulianDate) Weekday() time.Weekday {
+	return time.Weekday(mod(Julian{}.julianDayNumber(jd.Year, jd.Month, jd.Day)+1, 7))
+}
+
+// MonthName returns the English name of jd's month.
+func (jd JulianDate) MonthName() string {
+	return Julian{}.MonthName(jd.Year, jd.Month)
+}
+
+// String returns jd in the form YYYY-MM-DD.
+func (jd JulianDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", jd.Year, jd.Month, jd.Day)
+}
+
+// Format returns jd in the long form, e.g. "25 December 1582".
+func (jd JulianDate) Format() string {
+	return format(Julian{}, jd.Year, jd.Month, jd.Day, "")
+}
@@ -0,0 +1,67 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func TestJulianDateOf(t *testing.T) {
+	for _, tt := range []struct {
+		d    dt.Date
+		want JulianDate
+	}{
+		{dt.Date{Year: 1582, Month: 10, Day: 15, Valid: true}, JulianDate{1582, 10, 5}},
+		{dt.Date{Year: 2024, Month: 1, Day: 7, Valid: true}, JulianDate{2023, 12, 25}},
+		{dt.Date{Year: 1, Month: 1, Day: 1, Valid: true}, JulianDate{1, 1, 3}},
+		{dt.Date{Year: -43, Month: 3, Day: 13, Valid: true}, JulianDate{-43, 3, 15}},
+	} {
+		if got := JulianDateOf(tt.d); got != tt.want {
+			t.Errorf("JulianDateOf(%v): got %v, want %v", tt.d, got, tt.want)
+		}
+		if got, err := tt.want.ToDate(); err != nil || got != tt.d {
+			t.Errorf("%v.ToDate(): got %v, want %v (error %v)", tt.want, got, tt.d, err)
+		}
+	}
+}
+
+func TestJulianDateArithmetic(t *testing.T) {
+	jd := JulianDate{1900, 2, 29}
+	if !jd.IsValid() {
+		t.Errorf("expected %v to be valid", jd)
+	}
+	for _, tt := range []struct {
+		name string
+		got  JulianDate
+		want JulianDate
+	}{
+		{"Add days", jd.AddDays(1), JulianDate{1900, 3, 1}},
+		{"Subtract days", jd.AddDays(-60), JulianDate{1899, 12, 31}},
+		{"Add months", jd.AddMonths(11), JulianDate{1901, 1, 29}},
+		{"Add years to common year", jd.AddYears(1), JulianDate{1901, 2, 28}},
+		{"Subtract months across year 0", JulianDate{1, 1, 31}.AddMonths(-2), JulianDate{0, 11, 30}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestJulianDateFormat(t *testing.T) {
+	jd := JulianDate{1582, 10, 5}
+	if got := jd.Weekday(); got != time.Friday {
+		t.Errorf("expected %v, got %v", time.Friday, got)
+	}
+	if got, want := jd.String(), "1582-10-05"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := jd.Format(), "5 October 1582"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got, want := jd.MonthName(), "October"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,222 @@
+package dt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsLeapYear(t *testing.T) {
+	for _, tt := range []struct {
+		year int
+		want bool
+	}{
+		{2000, true},
+		{1900, false},
+		{2024, true},
+		{2023, false},
+		{0, true},
+		{-4, true},
+	} {
+		if got := IsLeapYear(tt.year); got != tt.want {
+			t.Errorf("IsLeapYear(%d): got %t, want %t", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	for _, tt := range []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2024, time.February, 29},
+		{2023, time.February, 28},
+		{1900, time.February, 28},
+		{2023, time.April, 30},
+		{2023, time.December, 31},
+	} {
+		if got := DaysInMonth(tt.year, tt.month); got != tt.want {
+			t.Errorf("DaysInMonth(%d, %v): got %d, want %d", tt.year, tt.month, got, tt.want)
+		}
+	}
+}
+
+func TestDateWeekday(t *testing.T) {
+	d := Date{2024, 1, 1, true}
+	if got := d.Weekday(); got != time.Monday {
+		t.Errorf("expected %v, got %v", time.Monday, got)
+	}
+}
+
+func TestDateQuarter(t *testing.T) {
+	for _, tt := range []struct {
+		month time.Month
+		want  int
+	}{
+		{time.January, 1},
+		{time.March, 1},
+		{time.April, 2},
+		{time.September, 3},
+		{time.December, 4},
+	} {
+		if got := (Date{2024, tt.month, 1, true}).Quarter(); got != tt.want {
+			t.Errorf("Quarter of %v: got %d, want %d", tt.month, got, tt.want)
+		}
+	}
+}
+
+func TestDateNavigation(t *testing.T) {
+	d := Date{2024, 5, 15, true} // Wednesday
+	cases := []struct {
+		name string
+		got  Date
+		want Date
+	}{
+		{
+			name: "Start of week on Monday",
+			got:  d.StartOfWeek(time.Monday),
+			want: Date{2024, 5, 13, true},
+		},
+		{
+			name: "Start of week on Sunday",
+			got:  d.StartOfWeek(time.Sunday),
+			want: Date{2024, 5, 12, true},
+		},
+		{
+			name: "Start of week on the same day",
+			got:  d.StartOfWeek(time.Wednesday),
+			want: d,
+		},
+		{
+			name: "End of week on Monday",
+			got:  d.EndOfWeek(time.Monday),
+			want: Date{2024, 5, 19, true},
+		},
+		{
+			name: "Start of month",
+			got:  d.StartOfMonth(),
+			want: Date{2024, 5, 1, true},
+		},
+		{
+			name: "End of month",
+			got:  d.EndOfMonth(),
+			want: Date{2024, 5, 31, true},
+		},
+		{
+			name: "End of leap February",
+			got:  Date{2024, 2, 3, true}.EndOfMonth(),
+			want: Date{2024, 2, 29, true},
+		},
+		{
+			name: "Start of quarter",
+			got:  d.StartOfQuarter(),
+			want: Date{2024, 4, 1, true},
+		},
+		{
+			name: "End of quarter",
+			got:  d.EndOfQuarter(),
+			want: Date{2024, 6, 30, true},
+		},
+		{
+			name: "Start of year",
+			got:  d.StartOfYear(),
+			want: Date{2024, 1, 1, true},
+		},
+		{
+			name: "End of year",
+			got:  d.EndOfYear(),
+			want: Date{2024, 12, 31, true},
+		},
+		{
+			name: "Next weekday",
+			got:  d.NextWeekday(time.Friday),
+			want: Date{2024, 5, 17, true},
+		},
+		{
+			name: "Next weekday on the same day",
+			got:  d.NextWeekday(time.Wednesday),
+			want: Date{2024, 5, 22, true},
+		},
+		{
+			name: "Previous weekday",
+			got:  d.PreviousWeekday(time.Monday),
+			want: Date{2024, 5, 13, true},
+		},
+		{
+			name: "Previous weekday on the same day",
+			got:  d.PreviousWeekday(time.Wednesday),
+			want: Date{2024, 5, 8, true},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	d := Date{2024, 9, 10, true}
+	cases := []struct {
+		name string
+		n    int
+		wd   time.Weekday
+		want Date
+	}{
+		{
+			name: "First Monday",
+			n:    1,
+			wd:   time.Monday,
+			want: Date{2024, 9, 2, true},
+		},
+		{
+			name: "First Sunday on the first day",
+			n:    1,
+			wd:   time.Sunday,
+			want: Date{2024, 9, 1, true},
+		},
+		{
+			name: "Third Thursday",
+			n:    3,
+			wd:   time.Thursday,
+			want: Date{2024, 9, 19, true},
+		},
+		{
+			name: "Fifth Monday",
+			n:    5,
+			wd:   time.Monday,
+			want: Date{2024, 9, 30, true},
+		},
+		{
+			name: "Fifth Tuesday does not exist",
+			n:    5,
+			wd:   time.Tuesday,
+		},
+		{
+			name: "Last Monday",
+			n:    -1,
+			wd:   time.Monday,
+			want: Date{2024, 9, 30, true},
+		},
+		{
+			name: "Second to last Friday",
+			n:    -2,
+			wd:   time.Friday,
+			want: Date{2024, 9, 20, true},
+		},
+		{
+			name: "Zero",
+			n:    0,
+			wd:   time.Friday,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.NthWeekdayOfMonth(tt.n, tt.wd); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
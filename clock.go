@@ -0,0 +1,128 @@
+package dt
+
+import (
+	"sync"
+	"time"
+)
+
+// A Clock provides the current time and timers. SystemClock is backed by the
+// time package, while FakeClock is controlled manually and meant for tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time
+	// on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock backed by time.Now and time.After.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Today returns the current date in loc according to SystemClock.
+func Today(loc *time.Location) Date {
+	return TodayFrom(SystemClock, loc)
+}
+
+// Now returns the current date and time in loc according to SystemClock.
+func Now(loc *time.Location) DateTime {
+	return NowFrom(SystemClock, loc)
+}
+
+// CurrentTime returns the current time of day in loc according to SystemClock.
+func CurrentTime(loc *time.Location) Time {
+	return CurrentTimeFrom(SystemClock, loc)
+}
+
+// TodayFrom returns the current date in loc according to c.
+//
+// TodayFrom panics if loc is nil.
+func TodayFrom(c Clock, loc *time.Location) Date {
+	return DateOf(c.Now().In(loc))
+}
+
+// NowFrom returns the current date and time in loc according to c.
+//
+// NowFrom panics if loc is nil.
+func NowFrom(c Clock, loc *time.Location) DateTime {
+	return DateTimeOf(c.Now().In(loc))
+}
+
+// CurrentTimeFrom returns the current time of day in loc according to c.
+//
+// CurrentTimeFrom panics if loc is nil.
+func CurrentTimeFrom(c Clock, loc *time.Location) Time {
+	return TimeOf(c.Now().In(loc))
+}
+
+// A FakeClock is a Clock whose time only changes when Set or Advance is called.
+// Channels returned by After fire once the clock reaches their deadline.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+}
+
+type fakeTimer struct {
+	deadline time.Time
+	c        chan time.Time
+}
+
+// NewFakeClock returns a FakeClock set to t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the time the clock is set to.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the clock's time once it has been
+// advanced by at least d. A non-positive d fires immediately.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.timers = append(c.timers, fakeTimer{deadline: c.now.Add(d), c: ch})
+	return ch
+}
+
+// Set sets the clock to t and fires all timers whose deadline is not after t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(t)
+}
+
+// Advance moves the clock forward by d and fires all timers that became due.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(c.now.Add(d))
+}
+
+// set sets the clock to t and fires the due timers. c.mu must be held.
+func (c *FakeClock) set(t time.Time) {
+	c.now = t
+	pending := c.timers[:0]
+	for _, tm := range c.timers {
+		if tm.deadline.After(t) {
+			pending = append(pending, tm)
+			continue
+		}
+		tm.c <- t
+	}
+	c.timers = pending
+}
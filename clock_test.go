@@ -0,0 +1,115 @@
+package dt
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClockHelpers(t *testing.T) {
+	loc := time.FixedZone("", 2*3600)
+	c := NewFakeClock(time.Date(2023, 12, 31, 23, 30, 0, 0, time.UTC))
+
+	if got, want := TodayFrom(c, time.UTC), (Date{2023, 12, 31, true}); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got, want := TodayFrom(c, loc), (Date{2024, 1, 1, true}); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got, want := NowFrom(c, loc), (DateTime{Date{2024, 1, 1, true}, Time{1, 30, true}}); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got, want := CurrentTimeFrom(c, loc), (Time{1, 30, true}); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSystemClockHelpers(t *testing.T) {
+	before := DateOf(time.Now().UTC())
+	got := Today(time.UTC)
+	after := DateOf(time.Now().UTC())
+	if got.Before(before) || got.After(after) {
+		t.Errorf("expected today between %v and %v, got %v", before, after, got)
+	}
+	if !Now(time.UTC).IsValid() {
+		t.Error("expected now to be valid")
+	}
+	if !CurrentTime(time.UTC).IsValid() {
+		t.Error("expected current time to be valid")
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("expected %v, got %v", start, got)
+	}
+
+	immediate := c.After(0)
+	short := c.After(time.Minute)
+	long := c.After(time.Hour)
+
+	select {
+	case <-immediate:
+	default:
+		t.Error("expected non-positive timer to fire immediately")
+	}
+
+	c.Advance(30 * time.Second)
+	select {
+	case <-short:
+		t.Error("timer fired too early")
+	default:
+	}
+
+	c.Advance(30 * time.Second)
+	select {
+	case got := <-short:
+		if want := start.Add(time.Minute); !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	default:
+		t.Error("expected timer to fire")
+	}
+
+	select {
+	case <-long:
+		t.Error("timer fired too early")
+	default:
+	}
+
+	end := start.Add(2 * time.Hour)
+	c.Set(end)
+	select {
+	case got := <-long:
+		if !got.Equal(end) {
+			t.Errorf("expected %v, got %v", end, got)
+		}
+	default:
+		t.Error("expected timer to fire")
+	}
+	if got := c.Now(); !got.Equal(end) {
+		t.Errorf("expected %v, got %v", end, got)
+	}
+}
+
+func TestFakeClockConcurrentAdvance(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				c.Advance(time.Second)
+			}
+		}()
+	}
+	wg.Wait()
+	if got, want := c.Now(), start.Add(5000*time.Second); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
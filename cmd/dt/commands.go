@@ -0,0 +1,451 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ribice/dt"
+	"github.com/ribice/dt/calendar"
+)
+
+func parseCmd(fs *flag.FlagSet) func(*env, []string) error {
+	layout := fs.String("layout", "", "Go reference layout to parse with, instead of natural language")
+	ref := fs.String("ref", "", "reference date and time for relative expressions (default now)")
+	dayFirst := fs.Bool("day-first", false, "read 3/4 as 3 April")
+	weekStart := fs.String("week-start", "monday", "first day of the week")
+	return func(e *env, args []string) error {
+		if len(args) == 0 {
+			return errUsage
+		}
+		input := strings.Join(args, " ")
+		type result struct {
+			Input    string        `json:"input"`
+			DateTime dt.DateTime   `json:"datetime"`
+			Range    *dt.DateRange `json:"range,omitempty"`
+			Fields   []string      `json:"fields"`
+		}
+		if *layout != "" {
+			t, err := time.Parse(*layout, input)
+			if err != nil {
+				return err
+			}
+			r := result{Input: input, DateTime: dt.DateTimeOf(t), Fields: []string{"year", "month", "day", "time"}}
+			return e.print(r, r.DateTime.String())
+		}
+
+		now := dt.NowFrom(e.clock, time.Local)
+		if *ref != "" {
+			v, err := parseValue(*ref)
+			if err != nil {
+				return err
+			}
+			now = v.DateTime
+		}
+		ws, err := parseWeekday(*weekStart)
+		if err != nil {
+			return err
+		}
+		res, err := dt.ParseNatural(input, now, &dt.NaturalOptions{DayFirst: *dayFirst, WeekStart: ws})
+		if err != nil {
+			return err
+		}
+		r := result{Input: input, DateTime: res.DateTime, Fields: []string{}}
+		for _, f := range []struct {
+			f    dt.Fields
+			name string
+		}{{dt.YearField, "year"}, {dt.MonthField, "month"}, {dt.DayField, "day"}, {dt.TimeField, "time"}} {
+			if res.Fields.Has(f.f) {
+				r.Fields = append(r.Fields, f.name)
+			}
+		}
+		text := value{DateTime: res.DateTime, hasTime: res.Fields.Has(dt.TimeField)}.String()
+		if res.IsRange() {
+			r.Range = &res.Range
+			text = res.Range.String()
+		}
+		return e.print(r, text)
+	}
+}
+
+// goLayouts are the named layouts of the time package accepted by format.
+var goLayouts = map[string]string{
+	"ansic":    time.ANSIC,
+	"rfc822":   time.RFC822,
+	"rfc850":   time.RFC850,
+	"rfc1123":  time.RFC1123,
+	"rfc3339":  time.RFC3339,
+	"kitchen":  time.Kitchen,
+	"dateonly": time.DateOnly,
+	"datetime": time.DateTime,
+	"timeonly": time.TimeOnly,
+}
+
+func formatCmd(fs *flag.FlagSet) func(*env, []string) error {
+	layout := fs.String("layout", "", "Go reference layout, or a name such as RFC1123 or Kitchen")
+	return func(e *env, args []string) error {
+		if len(args) != 1 || *layout == "" {
+			return errUsage
+		}
+		v, err := parseValue(args[0])
+		if err != nil {
+			return err
+		}
+		l := *layout
+		if named, ok := goLayouts[strings.ToLower(l)]; ok {
+			l = named
+		}
+		s := v.In(time.UTC).Format(l)
+		return e.print(struct {
+			Value     value  `json:"value"`
+			Formatted string `json:"formatted"`
+		}{v, s}, s)
+	}
+}
+
+// amount is a signed period of calendar units followed by a duration of
+// whole minutes.
+type amount struct {
+	period dt.Period
+	d      time.Duration
+}
+
+// parseAmount parses amounts such as 1y2m, -3w, 2h30m or P1Y2M10DT2H30M.
+// Amounts without the leading P are read as a period if they are one, and
+// as a Go duration otherwise, so 2m is two months and 2h30m a duration.
+func parseAmount(s string) (amount, error) {
+	var a amount
+	sign := ""
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		sign = s[:1]
+	}
+	body := strings.ToUpper(s[len(sign):])
+	if !strings.HasPrefix(body, "P") {
+		if p, err := dt.ParsePeriod(sign + "P" + body); err == nil {
+			return amount{period: p}, nil
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil || d%time.Minute != 0 {
+			return a, fmt.Errorf("invalid amount %q", s)
+		}
+		return amount{d: d}, nil
+	}
+	date, clock, hasTime := strings.Cut(body[1:], "T")
+	if date == "" && !hasTime {
+		return a, fmt.Errorf("invalid amount %q", s)
+	}
+	if date != "" {
+		p, err := dt.ParsePeriod(sign + "P" + date)
+		if err != nil {
+			return a, fmt.Errorf("invalid amount %q", s)
+		}
+		a.period = p
+	}
+	if hasTime {
+		d, err := time.ParseDuration(sign + strings.ToLower(clock))
+		if err != nil || d%time.Minute != 0 {
+			return a, fmt.Errorf("invalid amount %q", s)
+		}
+		a.d = d
+	}
+	return a, nil
+}
+
+// addTo returns v moved by a, adding the period first and then the duration.
+func (a amount) addTo(v value) value {
+	v.Date = v.Date.AddPeriod(a.period)
+	if a.d != 0 {
+		v.DateTime = dt.DateTimeOf(v.DateTime.In(time.UTC).Add(a.d))
+		v.hasTime = true
+	}
+	return v
+}
+
+func addCmd(fs *flag.FlagSet) func(*env, []string) error {
+	business := fs.Bool("business", false, "AMOUNT is a number of business days")
+	weekend := weekend(fs)
+	return func(e *env, args []string) error {
+		if len(args) != 2 {
+			return errUsage
+		}
+		v, err := parseValue(args[0])
+		if err != nil {
+			return err
+		}
+		if *business {
+			n, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid number of business days %q", args[1])
+			}
+			v.Date = dt.BusinessCalendar{Weekend: *weekend}.AddBusinessDays(v.Date, n)
+			if !v.Date.Valid {
+				return fmt.Errorf("no business days with weekend %s", weekend)
+			}
+		} else {
+			a, err := parseAmount(args[1])
+			if err != nil {
+				return err
+			}
+			v = a.addTo(v)
+		}
+		return e.print(struct {
+			Result value `json:"result"`
+		}{v}, v.String())
+	}
+}
+
+func diffCmd(fs *flag.FlagSet) func(*env, []string) error {
+	weekend := weekend(fs)
+	return func(e *env, args []string) error {
+		if len(args) != 2 {
+			return errUsage
+		}
+		from, err := parseValue(args[0])
+		if err != nil {
+			return err
+		}
+		to, err := parseValue(args[1])
+		if err != nil {
+			return err
+		}
+		r := struct {
+			Days         int  `json:"days"`
+			Weeks        int  `json:"weeks"`
+			Months       int  `json:"months"`
+			BusinessDays int  `json:"business_days"`
+			Minutes      *int `json:"minutes,omitempty"`
+		}{
+			Days:         to.Date.DaysSince(from.Date),
+			Months:       to.Date.MonthsSince(from.Date),
+			BusinessDays: dt.BusinessCalendar{Weekend: *weekend}.BusinessDaysBetween(from.Date, to.Date),
+		}
+		r.Weeks = r.Days / 7
+		text := fmt.Sprintf("%d days (%d weeks %d days), %d months, %d business days", r.Days, r.Weeks, r.Days%7, r.Months, r.BusinessDays)
+		if from.hasTime || to.hasTime {
+			m := r.Days*24*60 + (to.Time.Hour-from.Time.Hour)*60 + to.Time.Minute - from.Time.Minute
+			r.Minutes = &m
+			text += fmt.Sprintf(", %d minutes", m)
+		}
+		return e.print(r, text)
+	}
+}
+
+func infoCmd(fs *flag.FlagSet) func(*env, []string) error {
+	return func(e *env, args []string) error {
+		if len(args) > 1 {
+			return errUsage
+		}
+		d := e.today()
+		if len(args) == 1 {
+			v, err := parseValue(args[0])
+			if err != nil {
+				return err
+			}
+			d = v.Date
+		}
+		year, week := d.ToTime().ISOWeek()
+		r := struct {
+			Date        dt.Date `json:"date"`
+			Weekday     string  `json:"weekday"`
+			ISOWeek     string  `json:"iso_week"`
+			DayOfYear   int     `json:"day_of_year"`
+			Quarter     int     `json:"quarter"`
+			LeapYear    bool    `json:"leap_year"`
+			DaysInMonth int     `json:"days_in_month"`
+			UnixDay     int     `json:"unix_day"`
+			JulianDay   int     `json:"julian_day"`
+			ExcelSerial *int    `json:"excel_serial,omitempty"`
+		}{
+			Date:        d,
+			Weekday:     d.Weekday().String(),
+			ISOWeek:     fmt.Sprintf("%04d-W%02d", year, week),
+			DayOfYear:   d.DaysSince(d.StartOfYear()) + 1,
+			Quarter:     d.Quarter(),
+			LeapYear:    d.IsLeapYear(),
+			DaysInMonth: d.DaysInMonth(),
+			UnixDay:     d.UnixDays(),
+			JulianDay:   d.JulianDayNumber(),
+		}
+		if n, err := d.ExcelSerial(dt.Excel1900); err == nil {
+			r.ExcelSerial = &n
+		}
+		text := fmt.Sprintf("%s %s, week %s, day %d, Q%d", r.Date, r.Weekday, r.ISOWeek, r.DayOfYear, r.Quarter)
+		return e.print(r, text)
+	}
+}
+
+// calendars are the calendars of the calendar package accepted by convert.
+var calendars = map[string]calendar.Calendar{
+	"julian":  calendar.Julian{},
+	"islamic": calendar.Islamic{},
+	"hebrew":  calendar.Hebrew{},
+}
+
+// serials are the day-number systems accepted by convert.
+var serials = map[string]struct {
+	to   func(dt.Date) (int, error)
+	from func(int) (dt.Date, error)
+}{
+	"excel": {
+		func(d dt.Date) (int, error) { return d.ExcelSerial(dt.Excel1900) },
+		func(n int) (dt.Date, error) { return dt.DateFromExcelSerial(n, dt.Excel1900) },
+	},
+	"excel1904": {
+		func(d dt.Date) (int, error) { return d.ExcelSerial(dt.Excel1904) },
+		func(n int) (dt.Date, error) { return dt.DateFromExcelSerial(n, dt.Excel1904) },
+	},
+	"unix": {
+		func(d dt.Date) (int, error) { return d.UnixDays(), nil },
+		func(n int) (dt.Date, error) { return dt.DateFromUnixDays(n), nil },
+	},
+	"jdn": {
+		func(d dt.Date) (int, error) { return d.JulianDayNumber(), nil },
+		func(n int) (dt.Date, error) { return dt.DateFromJulianDayNumber(n), nil },
+	},
+	"mjd": {
+		func(d dt.Date) (int, error) { return d.ModifiedJulianDay(), nil },
+		func(n int) (dt.Date, error) { return dt.DateFromModifiedJulianDay(n), nil },
+	},
+}
+
+func convertCmd(fs *flag.FlagSet) func(*env, []string) error {
+	from := fs.String("from", "gregorian", "calendar system of VALUE")
+	to := fs.String("to", "gregorian", "calendar system to convert to")
+	fromZone := fs.String("from-zone", "", "time zone of VALUE, unless it has a UTC offset")
+	toZone := fs.String("to-zone", "", "time zone to convert to (default the local time zone)")
+	return func(e *env, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		if *fromZone != "" || *toZone != "" {
+			return convertZone(e, args[0], *fromZone, *toZone)
+		}
+		d, err := fromSystem(strings.ToLower(*from), args[0])
+		if err != nil {
+			return err
+		}
+		s, formatted, err := toSystem(strings.ToLower(*to), d)
+		if err != nil {
+			return err
+		}
+		text := s
+		if formatted != "" {
+			text += " (" + formatted + ")"
+		}
+		return e.print(struct {
+			Gregorian dt.Date `json:"gregorian"`
+			System    string  `json:"system"`
+			Value     string  `json:"value"`
+			Formatted string  `json:"formatted,omitempty"`
+		}{d, strings.ToLower(*to), s, formatted}, text)
+	}
+}
+
+// fromSystem parses s as a date of the named calendar system.
+func fromSystem(system, s string) (dt.Date, error) {
+	if system == "gregorian" {
+		return dt.ParseDate(s)
+	}
+	if c, ok := calendars[system]; ok {
+		var y, m, d int
+		if _, err := fmt.Sscanf(s, "%d-%d-%d", &y, &m, &d); err != nil {
+			return dt.Date{}, fmt.Errorf("invalid %s date %q, expected YEAR-MONTH-DAY", system, s)
+		}
+		return c.ToDate(y, m, d)
+	}
+	if conv, ok := serials[system]; ok {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return dt.Date{}, fmt.Errorf("invalid %s day number %q", system, s)
+		}
+		return conv.from(n)
+	}
+	return dt.Date{}, fmt.Errorf("unknown calendar system %q", system)
+}
+
+// toSystem returns d in the named calendar system, along with a written form
+// for calendars with named months.
+func toSystem(system string, d dt.Date) (string, string, error) {
+	if system == "gregorian" {
+		return d.String(), fmt.Sprintf("%d %s %d", d.Day, d.Month, d.Year), nil
+	}
+	if c, ok := calendars[system]; ok {
+		y, m, day := c.FromDate(d)
+		return fmt.Sprintf("%04d-%02d-%02d", y, m, day), fmt.Sprintf("%d %s %d", day, c.MonthName(y, m), y), nil
+	}
+	if conv, ok := serials[system]; ok {
+		n, err := conv.to(d)
+		return strconv.Itoa(n), "", err
+	}
+	return "", "", fmt.Errorf("unknown calendar system %q", system)
+}
+
+// convertZone converts a date and time from one time zone to another.
+// If VALUE carries a UTC offset, fromZone may be empty. An empty toZone is
+// the local time zone.
+func convertZone(e *env, s, fromZone, toZone string) error {
+	to := time.Local
+	if toZone != "" {
+		var err error
+		if to, err = time.LoadLocation(toZone); err != nil {
+			return err
+		}
+	}
+	var t time.Time
+	if odt, err := dt.ParseOffsetDateTime(s); err == nil {
+		t = odt.ToTime()
+	} else {
+		from, err := time.LoadLocation(fromZone)
+		if err != nil || fromZone == "" {
+			return fmt.Errorf("invalid -from-zone %q", fromZone)
+		}
+		v, err := parseValue(s)
+		if err != nil {
+			return err
+		}
+		t = v.In(from)
+	}
+	odt := dt.OffsetDateTimeOf(t.In(to))
+	return e.print(struct {
+		DateTime       dt.DateTime       `json:"datetime"`
+		OffsetDateTime dt.OffsetDateTime `json:"offset_datetime"`
+		Zone           string            `json:"zone"`
+	}{odt.DateTime, odt, to.String()}, odt.String())
+}
+
+func recurCmd(fs *flag.FlagSet) func(*env, []string) error {
+	start := fs.String("start", "", "first date of the recurrence (default today)")
+	limit := fs.Int("limit", 10, "maximum number of dates to print")
+	return func(e *env, args []string) error {
+		if len(args) != 1 || *limit < 0 {
+			return errUsage
+		}
+		r, err := dt.ParseRecurrence(args[0])
+		if err != nil {
+			return err
+		}
+		d := e.today()
+		if *start != "" {
+			if d, err = dt.ParseDate(*start); err != nil {
+				return err
+			}
+		}
+		dates := []dt.Date{}
+		for d := range r.Dates(d) {
+			if len(dates) == *limit {
+				break
+			}
+			dates = append(dates, d)
+		}
+		lines := make([]string, len(dates))
+		for i, d := range dates {
+			lines[i] = d.String()
+		}
+		return e.print(struct {
+			Rule  string    `json:"rule"`
+			Dates []dt.Date `json:"dates"`
+		}{r.String(), dates}, strings.Join(lines, "\n"))
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    amount
+		wantErr bool
+	}{
+		{in: "10d", want: amount{period: dt.Period{Days: 10}}},
+		{in: "+1y2m", want: amount{period: dt.Period{Years: 1, Months: 2}}},
+		{in: "-3w", want: amount{period: dt.Period{Days: -21}}},
+		{in: "2m", want: amount{period: dt.Period{Months: 2}}},
+		{in: "2h30m", want: amount{d: 150 * time.Minute}},
+		{in: "-90m5s", wantErr: true},
+		{in: "P1Y2M10DT2H30M", want: amount{period: dt.Period{Years: 1, Months: 2, Days: 10}, d: 150 * time.Minute}},
+		{in: "-P1DT45M", want: amount{period: dt.Period{Days: -1}, d: -45 * time.Minute}},
+		{in: "pt90m", want: amount{d: 90 * time.Minute}},
+		{in: "-P1W", want: amount{period: dt.Period{Days: -7}}},
+		{in: "P", wantErr: true},
+		{in: "PT", wantErr: true},
+		{in: "P1DT", wantErr: true},
+		{in: "-PT-1H", wantErr: true},
+		{in: "99999999999999999999d", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "d", wantErr: true},
+		{in: "5", wantErr: true},
+		{in: "5q", wantErr: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseAmount(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
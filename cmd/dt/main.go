@@ -0,0 +1,199 @@
+// Command dt performs date arithmetic and conversions on the command line.
+//
+// Usage:
+//
+//	dt parse [-layout LAYOUT] [-ref DATETIME] [-day-first] [-week-start DAY] TEXT...
+//	dt format -layout LAYOUT VALUE
+//	dt add [-business] [-weekend DAYS] VALUE AMOUNT
+//	dt diff [-weekend DAYS] FROM TO
+//	dt info [DATE]
+//	dt convert [-from SYSTEM] [-to SYSTEM] VALUE
+//	dt convert -from-zone ZONE -to-zone ZONE VALUE
+//	dt recur [-start DATE] [-limit N] RULE
+//
+// VALUE is a date (2024-05-15) or a date and time (2024-05-15T14:30).
+// AMOUNT is a signed period such as 1y2m, -3w or 10d, a duration such as
+// 2h30m, or the ISO 8601 form P1Y2M10DT2H30M. Calendar systems for convert are gregorian, julian,
+// islamic, hebrew, excel, excel1904, unix, jdn and mjd. RULE is an RFC 5545
+// recurrence rule such as FREQ=MONTHLY;BYDAY=-1FR.
+//
+// Every subcommand accepts -json to print its result as a JSON object.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, dt.SystemClock))
+}
+
+// env is the environment a command runs in.
+type env struct {
+	stdout io.Writer
+	clock  dt.Clock
+	json   bool
+}
+
+// A command runs a subcommand with its flag set, after flags were parsed.
+type command struct {
+	usage string
+	flags func(fs *flag.FlagSet) func(e *env, args []string) error
+}
+
+var commands = map[string]command{
+	"parse":   {"parse [-layout LAYOUT] [-ref DATETIME] [-day-first] [-week-start DAY] TEXT...", parseCmd},
+	"format":  {"format -layout LAYOUT VALUE", formatCmd},
+	"add":     {"add [-business] [-weekend DAYS] VALUE AMOUNT", addCmd},
+	"diff":    {"diff [-weekend DAYS] FROM TO", diffCmd},
+	"info":    {"info [DATE]", infoCmd},
+	"convert": {"convert [-from SYSTEM] [-to SYSTEM] [-from-zone ZONE -to-zone ZONE] VALUE", convertCmd},
+	"recur":   {"recur [-start DATE] [-limit N] RULE", recurCmd},
+}
+
+// errUsage reports invalid arguments; the usage of the command is printed.
+var errUsage = errors.New("invalid arguments")
+
+// run runs the command line args and returns the exit code.
+func run(args []string, stdout, stderr io.Writer, clock dt.Clock) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "dt: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+	e := &env{stdout: stdout, clock: clock}
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&e.json, "json", false, "print the result as JSON")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: dt %s\n", cmd.usage)
+		fs.PrintDefaults()
+	}
+	exec := cmd.flags(fs)
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if err := exec(e, fs.Args()); err != nil {
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+			return 2
+		}
+		fmt.Fprintf(stderr, "dt: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "usage: dt COMMAND [-json] [ARGS]")
+	for _, name := range names {
+		fmt.Fprintf(w, "  dt %s\n", commands[name].usage)
+	}
+}
+
+// print writes v as JSON if -json was given, and text otherwise.
+func (e *env) print(v interface{}, text string) error {
+	if e.json {
+		return json.NewEncoder(e.stdout).Encode(v)
+	}
+	_, err := fmt.Fprintln(e.stdout, text)
+	return err
+}
+
+// today returns the current date of the environment's clock.
+func (e *env) today() dt.Date {
+	return dt.TodayFrom(e.clock, time.Local)
+}
+
+// value is a date with an optional time of day.
+type value struct {
+	dt.DateTime
+	hasTime bool
+}
+
+// parseValue parses a date or a date and time.
+func parseValue(s string) (value, error) {
+	if d, err := dt.ParseDate(s); err == nil {
+		return value{DateTime: dt.DateTime{Date: d, Time: dt.Time{Valid: true}}}, nil
+	}
+	d, err := dt.ParseDateTime(s)
+	if err != nil {
+		return value{}, fmt.Errorf("%q is neither a date nor a date and time", s)
+	}
+	return value{DateTime: d, hasTime: true}, nil
+}
+
+func (v value) String() string {
+	if v.hasTime {
+		return v.DateTime.String()
+	}
+	return v.Date.String()
+}
+
+func (v value) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// parseWeekday parses an English weekday name or abbreviation.
+func parseWeekday(s string) (time.Weekday, error) {
+	s = strings.ToLower(s)
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		if len(s) >= 2 && strings.HasPrefix(name, s) {
+			return wd, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", s)
+}
+
+// weekendFlag is a comma-separated list of weekdays.
+type weekendFlag []time.Weekday
+
+func (w *weekendFlag) String() string {
+	names := make([]string, len(*w))
+	for i, wd := range *w {
+		names[i] = strings.ToLower(wd.String()[:3])
+	}
+	return strings.Join(names, ",")
+}
+
+func (w *weekendFlag) Set(s string) error {
+	*w = weekendFlag{}
+	for _, name := range strings.Split(s, ",") {
+		if name == "" {
+			continue
+		}
+		wd, err := parseWeekday(name)
+		if err != nil {
+			return err
+		}
+		*w = append(*w, wd)
+	}
+	return nil
+}
+
+func weekend(fs *flag.FlagSet) *weekendFlag {
+	w := &weekendFlag{time.Saturday, time.Sunday}
+	fs.Var(w, "weekend", "comma-separated days that are not business days")
+	return w
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func runArgs(t *testing.T, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	clock := dt.NewFakeClock(time.Date(2024, time.May, 15, 10, 0, 0, 0, time.Local))
+	code := run(args, &stdout, &stderr, clock)
+	return strings.TrimSpace(stdout.String()), stderr.String(), code
+}
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"parse", "tomorrow", "9am"}, "2024-05-16T09:00"},
+		{[]string{"parse", "-week-start", "mon", "next", "week"}, "2024-05-20/2024-05-26"},
+		{[]string{"parse", "-day-first", "3/4"}, "2024-04-03"},
+		{[]string{"parse", "-layout", "02.01.2006 15:04", "15.05.2024 18:30"}, "2024-05-15T18:30"},
+		{[]string{"format", "-layout", "Monday, 2 January 2006", "2024-05-15"}, "Wednesday, 15 May 2024"},
+		{[]string{"format", "-layout", "kitchen", "2024-05-15T18:30"}, "6:30PM"},
+		{[]string{"add", "2024-01-31", "1m"}, "2024-02-29"},
+		{[]string{"add", "2024-05-15T23:30", "P1DT45M"}, "2024-05-17T00:15"},
+		{[]string{"add", "-business", "2024-05-17", "1"}, "2024-05-20"},
+		{[]string{"add", "-business", "-weekend", "fri,sat", "2024-05-16", "1"}, "2024-05-19"},
+		{[]string{"diff", "2024-05-01", "2024-05-15"}, "14 days (2 weeks 0 days), 0 months, 10 business days"},
+		{[]string{"info"}, "2024-05-15 Wednesday, week 2024-W20, day 136, Q2"},
+		{[]string{"convert", "-to", "hebrew", "2024-10-03"}, "5785-07-01 (1 Tishrei 5785)"},
+		{[]string{"convert", "-from", "islamic", "-to", "julian", "1445-09-01"}, "2024-02-27 (27 February 2024)"},
+		{[]string{"convert", "-from", "excel", "45000"}, "2023-03-15 (15 March 2023)"},
+		{[]string{"convert", "-to", "mjd", "2024-05-15"}, "60445"},
+		{[]string{"convert", "-from-zone", "Europe/Berlin", "-to-zone", "America/New_York", "2024-05-15T10:00"}, "2024-05-15T04:00:00-04:00"},
+		{[]string{"convert", "-to-zone", "Asia/Tokyo", "2024-05-15T10:00:00Z"}, "2024-05-15T19:00:00+09:00"},
+		{[]string{"recur", "-limit", "2", "FREQ=WEEKLY;BYDAY=MO"}, "2024-05-20\n2024-05-27"},
+	}
+	for _, tt := range cases {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			got, stderr, code := runArgs(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code %d: %s", code, stderr)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{
+			[]string{"parse", "-json", "end of month"},
+			`{"input":"end of month","datetime":"2024-05-31T00:00","fields":["year","month","day"]}`,
+		},
+		{
+			[]string{"diff", "-json", "2024-05-15T10:00", "2024-05-16T09:30"},
+			`{"days":1,"weeks":0,"months":0,"business_days":1,"minutes":1410}`,
+		},
+		{
+			[]string{"info", "-json", "2024-02-29"},
+			`{"date":"2024-02-29","weekday":"Thursday","iso_week":"2024-W09","day_of_year":60,"quarter":1,"leap_year":true,` +
+				`"days_in_month":29,"unix_day":19782,"julian_day":2460370,"excel_serial":45351}`,
+		},
+		{
+			[]string{"recur", "-json", "-start", "2024-01-01", "FREQ=YEARLY;COUNT=2"},
+			`{"rule":"FREQ=YEARLY;COUNT=2","dates":["2024-01-01","2025-01-01"]}`,
+		},
+		{
+			[]string{"add", "-json", "2024-05-15", "-2w"},
+			`{"result":"2024-05-01"}`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			got, stderr, code := runArgs(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code %d: %s", code, stderr)
+			}
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConvertToLocalZone(t *testing.T) {
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+	time.Local = time.FixedZone("", 9*60*60)
+	got, stderr, code := runArgs(t, "convert", "-from-zone", "Europe/Berlin", "2024-05-15T10:00")
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+	if want := "2024-05-15T17:00:00+09:00"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	cases := []struct {
+		args []string
+		code int
+	}{
+		{nil, 2},
+		{[]string{"unknown"}, 2},
+		{[]string{"diff", "2024-05-15"}, 2},
+		{[]string{"add", "-unknown", "2024-05-15", "1d"}, 2},
+		{[]string{"add", "2024-05-15", "1q"}, 1},
+		{[]string{"parse", "someday"}, 1},
+		{[]string{"convert", "-to", "mayan", "2024-05-15"}, 1},
+		{[]string{"convert", "-from", "hebrew", "5785-13-01"}, 1},
+		{[]string{"convert", "-to-zone", "UTC", "2024-05-15T10:00"}, 1},
+		{[]string{"recur", "FREQ=SECONDLY"}, 1},
+		{[]string{"recur", "-limit", "-1", "FREQ=DAILY"}, 2},
+	}
+	for _, tt := range cases {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			_, stderr, code := runArgs(t, tt.args...)
+			if code != tt.code {
+				t.Errorf("expected exit code %d, got %d", tt.code, code)
+			}
+			if stderr == "" {
+				t.Error("expected a message on stderr")
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package codectest
+
+import "github.com/ribice/dt"
+
+// The values of the TOML specification's examples of local dates and times.
+var (
+	date  = dt.Date{Year: 1979, Month: 5, Day: 27, Valid: true}
+	clock = dt.Time{Hour: 7, Minute: 32, Valid: true}
+)
@@ -0,0 +1,4 @@
+// Package codectest tests the TOML and YAML methods of dt types with the
+// libraries they are written for. It is a separate module so that dt does
+// not depend on those libraries.
+package codectest
@@ -0,0 +1,9 @@
+module github.com/ribice/dt/codectest
+
+go 1.23.3
+
+require (
+	github.com/BurntSushi/toml v1.4.0
+	github.com/ribice/dt v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,112 @@
+package codectest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/ribice/dt"
+)
+
+type tomlConfig struct {
+	D  dt.Date     `toml:"d"`
+	T  dt.Time     `toml:"t"`
+	DT dt.DateTime `toml:"dt"`
+}
+
+func TestUnmarshalTOML(t *testing.T) {
+	full := tomlConfig{
+		D:  date,
+		T:  clock,
+		DT: dt.DateTime{Date: date, Time: clock},
+	}
+	cases := []struct {
+		name    string
+		req     string
+		want    tomlConfig
+		wantErr bool
+	}{
+		{
+			name: "Native literals",
+			req:  "d = 1979-05-27\nt = 07:32:00\ndt = 1979-05-27T07:32:00\n",
+			want: full,
+		},
+		{
+			name: "Native offset datetime",
+			req:  "dt = 1979-05-27T07:32:00-08:00\n",
+			want: tomlConfig{DT: full.DT},
+		},
+		{
+			name: "Quoted strings",
+			req:  "d = \"1979-05-27\"\nt = \"07:32\"\ndt = \"1979-05-27 07:32\"\n",
+			want: full,
+		},
+		{
+			name: "Empty strings",
+			req:  "d = \"\"\nt = \"\"\ndt = \"\"\n",
+		},
+		{
+			name:    "Invalid time",
+			req:     "t = \"25:00\"\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported type",
+			req:     "d = 12\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var got tomlConfig
+			_, err := toml.Decode(tt.req, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMarshalTOML(t *testing.T) {
+	cases := []struct {
+		name string
+		req  tomlConfig
+		want string
+	}{
+		{
+			name: "Valid values",
+			req: tomlConfig{
+				D:  date,
+				T:  clock,
+				DT: dt.DateTime{Date: date, Time: clock},
+			},
+			want: "d = 1979-05-27\nt = 07:32:00\ndt = 1979-05-27T07:32:00\n",
+		},
+		{
+			name: "Invalid values",
+			want: "d = \"\"\nt = \"\"\ndt = \"\"\n",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := toml.NewEncoder(&buf).Encode(tt.req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+
+			var back tomlConfig
+			if _, err := toml.Decode(buf.String(), &back); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if back != tt.req {
+				t.Errorf("expected %v, got %v", tt.req, back)
+			}
+		})
+	}
+}
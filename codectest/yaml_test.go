@@ -0,0 +1,126 @@
+package codectest
+
+import (
+	"testing"
+
+	"github.com/ribice/dt"
+	"gopkg.in/yaml.v3"
+)
+
+type yamlConfig struct {
+	D  dt.Date     `yaml:"d"`
+	T  dt.Time     `yaml:"t"`
+	DT dt.DateTime `yaml:"dt"`
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	full := yamlConfig{
+		D:  date,
+		T:  clock,
+		DT: dt.DateTime{Date: date, Time: clock},
+	}
+	cases := []struct {
+		name    string
+		req     string
+		want    yamlConfig
+		wantErr bool
+	}{
+		{
+			name: "Native literals",
+			req:  "d: 1979-05-27\nt: 07:32:00\ndt: 1979-05-27T07:32:00\n",
+			want: full,
+		},
+		{
+			name: "Native timestamp with offset",
+			req:  "dt: 1979-05-27T07:32:00-08:00\n",
+			want: yamlConfig{DT: full.DT},
+		},
+		{
+			name: "Quoted strings",
+			req:  "d: \"1979-05-27\"\nt: '07:32'\ndt: \"1979-05-27 07:32\"\n",
+			want: full,
+		},
+		{
+			name: "Nulls",
+			req:  "d: null\nt: ~\ndt:\n",
+		},
+		{
+			name:    "Invalid date",
+			req:     "d: 1979-02-30\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported type",
+			req:     "t: [1, 2]\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var got yamlConfig
+			err := yaml.Unmarshal([]byte(tt.req), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	cases := []struct {
+		name    string
+		req     yamlConfig
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Valid values",
+			req: yamlConfig{
+				D:  date,
+				T:  clock,
+				DT: dt.DateTime{Date: date, Time: clock},
+			},
+			want: "d: \"1979-05-27\"\nt: \"07:32\"\ndt: 1979-05-27T07:32\n",
+		},
+		{
+			name: "Invalid values",
+			want: "d: null\nt: null\ndt: null\n",
+		},
+		{
+			name:    "Out of range",
+			req:     yamlConfig{T: dt.Time{Hour: 24, Valid: true}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := yaml.Marshal(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPackedDateYAML(t *testing.T) {
+	type doc struct {
+		D dt.PackedDate `yaml:"d"`
+		E dt.PackedDate `yaml:"e"`
+	}
+	p, _ := dt.PackDate(dt.Date{Year: 2024, Month: 5, Day: 15, Valid: true})
+	in := doc{D: p}
+	y, err := yaml.Marshal(in)
+	if err != nil || string(y) != "d: \"2024-05-15\"\ne: null\n" {
+		t.Errorf("unexpected YAML %q (error %v)", y, err)
+	}
+	var out doc
+	if err := yaml.Unmarshal([]byte("d: 2024-05-15\ne: null\n"), &out); err != nil || out != in {
+		t.Errorf("expected %v, got %v (error %v)", in, out, err)
+	}
+}
@@ -16,6 +16,7 @@
 package dt
 
 import (
+	"cmp"
 	"database/sql/driver"
 	"fmt"
 	"time"
@@ -39,9 +40,20 @@ func DateOf(t time.Time) Date {
 	return d
 }
 
+// NewDate returns the Date for the given year, month and day.
+// It returns a *RangeError if the values do not form a calendar date.
+func NewDate(year int, month time.Month, day int) (Date, error) {
+	d := Date{Year: year, Month: month, Day: day, Valid: true}
+	if err := d.check(); err != nil {
+		return Date{}, err
+	}
+	return d, nil
+}
+
 // ParseDate parses a string in RFC3339 full-date format and returns the date value it represents.
+// Failures are reported as a *ParseError.
 func ParseDate(s string) (Date, error) {
-	t, err := time.Parse("2006-01-02", s)
+	t, err := parseLayouts("date", s, "2006-01-02")
 	if err != nil {
 		return Date{}, err
 	}
@@ -56,6 +68,33 @@ func (d Date) String() string {
 	return ""
 }
 
+// IsValid reports whether d is Valid and represents an existing calendar date.
+func (d Date) IsValid() bool {
+	return d.Valid && d.check() == nil
+}
+
+// Normalize returns d with out-of-range months and days carried over into
+// the following or preceding months, the same way time.Date does.
+// For example, February 30th 2023 normalizes to March 2nd 2023.
+// Dates that are not Valid are returned unchanged.
+func (d Date) Normalize() Date {
+	if !d.Valid {
+		return d
+	}
+	return DateFromUnixDays(d.UnixDays())
+}
+
+// check returns a *RangeError describing the first out-of-range field of d.
+func (d Date) check() error {
+	if d.Month < time.January || d.Month > time.December {
+		return &RangeError{Field: "month", Value: int(d.Month)}
+	}
+	if d.Day < 1 || d.Day > DaysInMonth(d.Year, d.Month) {
+		return &RangeError{Field: "day", Value: d.Day}
+	}
+	return nil
+}
+
 // In returns the time corresponding to time 00:00:00 of the date in the location.
 //
 // In is always consistent with time.Date, even when time.Date returns a time
@@ -77,38 +116,54 @@ func (d Date) In(loc *time.Location) time.Time {
 // AddDays returns the date that is n days in the future.
 // n can also be negative to go into the past.
 func (d Date) AddDays(n int) Date {
-	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+	return DateFromUnixDays(d.UnixDays() + n)
+}
+
+// AddMonths returns the date that is n months in the future, or in the past
+// for negative n. If the day does not exist in the resulting month, it is
+// limited to the last day of the month, so January 31st plus one month is
+// the last day of February.
+func (d Date) AddMonths(n int) Date {
+	m := d.Year*12 + int(d.Month) - 1 + n
+	d.Year, d.Month = floorDiv(m, 12), time.Month(m-floorDiv(m, 12)*12+1)
+	d.Day = min(d.Day, d.DaysInMonth())
+	return d
+}
+
+// AddYears returns the date that is n years in the future, or in the past for
+// negative n. February 29th becomes February 28th in common years.
+func (d Date) AddYears(n int) Date {
+	return d.AddMonths(12 * n)
 }
 
 // DaysSince returns the signed number of days between the date and s, not including the end day.
 // This is the inverse operation to AddDays.
 func (d Date) DaysSince(s Date) (days int) {
-	// We convert to Unix time so we do not have to worry about leap seconds:
-	// Unix time increases by exactly 86400 seconds per day.
-	deltaUnix := d.In(time.UTC).Unix() - s.In(time.UTC).Unix()
-	return int(deltaUnix / 86400)
+	return d.UnixDays() - s.UnixDays()
+}
+
+// MonthsSince returns the signed number of whole calendar months between the
+// date and s. A month is whole once the day of the month of s is reached, so
+// there is no whole month between January 31st and February 29th.
+func (d Date) MonthsSince(s Date) int {
+	m := (d.Year-s.Year)*12 + int(d.Month-s.Month)
+	switch {
+	case m > 0 && d.Day < s.Day:
+		m--
+	case m < 0 && d.Day > s.Day:
+		m++
+	}
+	return m
 }
 
 // Before reports whether d1 occurs before d2.
 func (d Date) Before(d2 Date) bool {
-	if d.Year != d2.Year {
-		return d.Year < d2.Year
-	}
-	if d.Month != d2.Month {
-		return d.Month < d2.Month
-	}
-	return d.Day < d2.Day
+	return d.UnixDays() < d2.UnixDays()
 }
 
 // After reports whether d1 occurs after d2.
 func (d Date) After(d2 Date) bool {
-	if d.Year != d2.Year {
-		return d.Year > d2.Year
-	}
-	if d.Month != d2.Month {
-		return d.Month > d2.Month
-	}
-	return d.Day > d2.Day
+	return d.UnixDays() > d2.UnixDays()
 }
 
 // ToTime converts Date to Go time
@@ -117,8 +172,14 @@ func (d Date) ToTime() time.Time {
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
-// The output is the result of d.String().
+// The output is the result of d.String(). A Valid date holding an
+// out-of-range field results in a *RangeError.
 func (d Date) MarshalText() ([]byte, error) {
+	if d.Valid {
+		if err := d.check(); err != nil {
+			return nil, err
+		}
+	}
 	return []byte(d.String()), nil
 }
 
@@ -136,6 +197,9 @@ func (d *Date) UnmarshalText(data []byte) error {
 // Value implements valuer interface
 func (d Date) Value() (driver.Value, error) {
 	if d.Valid {
+		if err := d.check(); err != nil {
+			return nil, err
+		}
 		return driver.Value(d.String()), nil
 	}
 	return nil, nil
@@ -169,13 +233,5 @@ func (d *Date) Scan(value interface{}) error {
 // Compare compares d and d2. If d is before d2, it returns -1;
 // if d is after d2, it returns +1; otherwise it returns 0.
 func (d Date) Compare(d2 Date) int {
-	if d.Before(d2) {
-		return -1
-	}
-
-	if d2.Before(d) {
-		return 1
-	}
-
-	return 0
+	return cmp.Compare(d.UnixDays(), d2.UnixDays())
 }
@@ -2,6 +2,8 @@ package dt
 
 import (
 	"encoding/json"
+	"errors"
+	"math/rand/v2"
 	"testing"
 	"time"
 )
@@ -197,6 +199,69 @@ func TestDateArithmetic(t *testing.T) {
 	}
 }
 
+func TestDateAddMonths(t *testing.T) {
+	cases := []struct {
+		name   string
+		start  Date
+		months int
+		want   Date
+	}{
+		{
+			name:   "same day next month",
+			start:  Date{2024, 1, 15, true},
+			months: 1,
+			want:   Date{2024, 2, 15, true},
+		},
+		{
+			name:   "end of month clamped",
+			start:  Date{2024, 1, 31, true},
+			months: 1,
+			want:   Date{2024, 2, 29, true},
+		},
+		{
+			name:   "crossing a year boundary backwards",
+			start:  Date{2024, 3, 31, true},
+			months: -4,
+			want:   Date{2023, 11, 30, true},
+		},
+		{
+			name:   "before year zero",
+			start:  Date{1, 1, 1, true},
+			months: -13,
+			want:   Date{-1, 12, 1, true},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.start.AddMonths(tt.months); got != tt.want {
+				t.Errorf("%v.AddMonths(%d) = %v, want %v", tt.start, tt.months, got, tt.want)
+			}
+		})
+	}
+	if got, want := (Date{2024, 2, 29, true}).AddYears(1), (Date{2025, 2, 28, true}); got != want {
+		t.Errorf("AddYears from leap day = %v, want %v", got, want)
+	}
+}
+
+func TestDateMonthsSince(t *testing.T) {
+	cases := []struct {
+		s, d Date
+		want int
+	}{
+		{Date{2024, 1, 31, true}, Date{2024, 2, 29, true}, 0},
+		{Date{2024, 1, 15, true}, Date{2024, 3, 15, true}, 2},
+		{Date{2024, 1, 15, true}, Date{2024, 3, 14, true}, 1},
+		{Date{2024, 3, 15, true}, Date{2023, 12, 20, true}, -2},
+		{Date{2024, 3, 15, true}, Date{2023, 3, 15, true}, -12},
+		{Date{2024, 5, 15, true}, Date{2024, 5, 1, true}, 0},
+	}
+	for _, tt := range cases {
+		if got := tt.d.MonthsSince(tt.s); got != tt.want {
+			t.Errorf("%v.MonthsSince(%v) = %d, want %d", tt.d, tt.s, got, tt.want)
+		}
+	}
+}
+
 func TestDateBefore(t *testing.T) {
 	for _, tt := range []struct {
 		d1, d2 Date
@@ -372,3 +437,175 @@ func TestDateCompare(t *testing.T) {
 		}
 	}
 }
+
+func TestNewDate(t *testing.T) {
+	cases := []struct {
+		name      string
+		year, day int
+		month     time.Month
+		want      Date
+		wantField string
+	}{
+		{
+			name:  "Valid date",
+			year:  2024,
+			month: 2,
+			day:   29,
+			want:  Date{2024, 2, 29, true},
+		},
+		{
+			name:      "Day out of range",
+			year:      2023,
+			month:     2,
+			day:       29,
+			wantField: "day",
+		},
+		{
+			name:      "Month out of range",
+			year:      2023,
+			month:     13,
+			day:       1,
+			wantField: "month",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDate(tt.year, tt.month, tt.day)
+			var rerr *RangeError
+			if errors.As(err, &rerr) != (tt.wantField != "") {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rerr != nil && rerr.Field != tt.wantField {
+				t.Errorf("expected field %s, got %s", tt.wantField, rerr.Field)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDateIsValid(t *testing.T) {
+	for _, tt := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2024, 2, 29, true}, true},
+		{Date{2024, 2, 29, false}, false},
+		{Date{2024, 2, 31, true}, false},
+		{Date{2024, 0, 1, true}, false},
+		{Date{2024, 1, 0, true}, false},
+	} {
+		if got := tt.d.IsValid(); got != tt.want {
+			t.Errorf("%#v.IsValid(): got %t, want %t", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestDateNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		d, want Date
+	}{
+		{Date{2024, 2, 31, true}, Date{2024, 3, 2, true}},
+		{Date{2023, 13, 1, true}, Date{2024, 1, 1, true}},
+		{Date{2024, 3, 0, true}, Date{2024, 2, 29, true}},
+		{Date{2024, 3, 0, false}, Date{2024, 3, 0, false}},
+	} {
+		if got := tt.d.Normalize(); got != tt.want {
+			t.Errorf("%#v.Normalize(): got %v, want %v", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestDateRejectOutOfRange(t *testing.T) {
+	d := Date{2024, 2, 31, true}
+	var rerr *RangeError
+	if _, err := d.Value(); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from Value, got %v", err)
+	}
+	if _, err := d.MarshalText(); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from MarshalText, got %v", err)
+	}
+	if _, err := json.Marshal(d); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from json.Marshal, got %v", err)
+	}
+}
+
+// randomDate returns a date between the years -10000 and 10000, which both
+// the day count and time.Time can represent.
+func randomDate(r *rand.Rand) Date {
+	return DateOf(time.Date(r.IntN(20001)-10000, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, r.IntN(366)))
+}
+
+func TestDateMatchesTime(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10000; i++ {
+		d1, d2 := randomDate(r), randomDate(r)
+		t1, t2 := d1.In(time.UTC), d2.In(time.UTC)
+		n := r.IntN(200000) - 100000
+		if got, want := d1.AddDays(n), DateOf(t1.AddDate(0, 0, n)); got != want {
+			t.Fatalf("%v.AddDays(%d): got %v, want %v", d1, n, got, want)
+		}
+		if got, want := d1.DaysSince(d2), int((t1.Unix()-t2.Unix())/86400); got != want {
+			t.Fatalf("%v.DaysSince(%v): got %d, want %d", d1, d2, got, want)
+		}
+		if got, want := d1.Compare(d2), t1.Compare(t2); got != want {
+			t.Fatalf("%v.Compare(%v): got %d, want %d", d1, d2, got, want)
+		}
+		if d1.Before(d2) != t1.Before(t2) || d1.After(d2) != t1.After(t2) {
+			t.Fatalf("%v and %v: Before/After disagree with time.Time", d1, d2)
+		}
+		if got, want := d1.Weekday(), t1.Weekday(); got != want {
+			t.Fatalf("%v.Weekday(): got %v, want %v", d1, got, want)
+		}
+		un := Date{d1.Year, d1.Month + time.Month(r.IntN(61)-30), d1.Day + r.IntN(801) - 400, true}
+		if got, want := un.Normalize(), DateOf(un.In(time.UTC)); got != want {
+			t.Fatalf("%#v.Normalize(): got %v, want %v", un, got, want)
+		}
+	}
+}
+
+func TestDateBeyondTime(t *testing.T) {
+	for _, d := range []Date{
+		{-1_000_000, time.March, 1, true},
+		{1_000_000, time.February, 29, true},
+		{-400_000_000, time.December, 31, true},
+		{400_000_000, time.January, 1, true},
+	} {
+		for _, n := range []int{-1, 1, 146097, -146097 * 1000} {
+			if got := d.AddDays(n).DaysSince(d); got != n {
+				t.Errorf("%v.AddDays(%d).DaysSince: got %d", d, n, got)
+			}
+		}
+		if got := d.AddDays(146097); got.Year != d.Year+400 || got.Month != d.Month || got.Day != d.Day {
+			t.Errorf("%v plus 400 years: got %v", d, got)
+		}
+		if !d.Before(d.AddDays(1)) || !d.After(d.AddDays(-1)) {
+			t.Errorf("%v: expected ordering by day", d)
+		}
+	}
+}
+
+func BenchmarkDate(b *testing.B) {
+	d1, d2 := Date{2024, time.May, 15, true}, Date{1999, time.December, 31, true}
+	b.Run("AddDays", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d1.AddDays(i % 1000)
+		}
+	})
+	b.Run("DaysSince", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d1.DaysSince(d2)
+		}
+	})
+	b.Run("Compare", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d1.Compare(d2)
+		}
+	})
+	b.Run("Weekday", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d1.Weekday()
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package dt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A DateRange represents the days from Start through End, both inclusive.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// NewDateRange returns the range of days from start through end.
+// It returns an error if either date is not Valid or end is before start.
+func NewDateRange(start, end Date) (DateRange, error) {
+	r := DateRange{Start: start, End: end}
+	if err := r.check(); err != nil {
+		return DateRange{}, err
+	}
+	return r, nil
+}
+
+// ParseDateRange parses a string in the ISO 8601 interval format
+// YYYY-MM-DD/YYYY-MM-DD and returns the range it represents.
+// Failures are reported as a *ParseError.
+func ParseDateRange(s string) (DateRange, error) {
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return DateRange{}, &ParseError{Type: "date range", Input: s, Expected: []string{"YYYY-MM-DD/YYYY-MM-DD"}, Offset: len(s)}
+	}
+	var r DateRange
+	for _, p := range []struct {
+		d      *Date
+		s      string
+		offset int
+	}{{&r.Start, start, 0}, {&r.End, end, len(start) + 1}} {
+		d, err := ParseDate(p.s)
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				pe.Type, pe.Input, pe.Offset = "date range", s, pe.Offset+p.offset
+				pe.Expected = []string{"YYYY-MM-DD/YYYY-MM-DD"}
+			}
+			return DateRange{}, err
+		}
+		*p.d = d
+	}
+	if err := r.check(); err != nil {
+		return DateRange{}, err
+	}
+	return r, nil
+}
+
+// String returns the range in the ISO 8601 interval format YYYY-MM-DD/YYYY-MM-DD.
+func (r DateRange) String() string {
+	if !r.Start.Valid || !r.End.Valid {
+		return ""
+	}
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// IsValid reports whether both ends of r are valid dates and End is not before Start.
+func (r DateRange) IsValid() bool {
+	return r.Start.IsValid() && r.End.IsValid() && !r.End.Before(r.Start)
+}
+
+// check returns an error if r is not valid.
+func (r DateRange) check() error {
+	if !r.Start.Valid || !r.End.Valid {
+		return errors.New("dt: date range requires valid start and end dates")
+	}
+	if err := r.Start.check(); err != nil {
+		return err
+	}
+	if err := r.End.check(); err != nil {
+		return err
+	}
+	if r.End.Before(r.Start) {
+		return fmt.Errorf("dt: date range end %v is before start %v", r.End, r.Start)
+	}
+	return nil
+}
+
+// Days returns the number of days in r, counting both Start and End.
+func (r DateRange) Days() int {
+	return r.End.DaysSince(r.Start) + 1
+}
+
+// Contains reports whether d falls within r.
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// Overlaps reports whether r and r2 have at least one day in common.
+func (r DateRange) Overlaps(r2 DateRange) bool {
+	return !r.End.Before(r2.Start) && !r2.End.Before(r.Start)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String(). A range that has valid dates
+// but is otherwise not valid results in an error.
+func (r DateRange) MarshalText() ([]byte, error) {
+	if r.Start.Valid && r.End.Valid {
+		if err := r.check(); err != nil {
+			return nil, err
+		}
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The range is expected to be a string in a format accepted by ParseDateRange.
+func (r *DateRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseDateRange(string(data))
+	return err
+}
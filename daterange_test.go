@@ -0,0 +1,128 @@
+package dt
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDateRange(t *testing.T) {
+	cases := []struct {
+		name    string
+		str     string
+		want    DateRange
+		wantErr bool
+		offset  int
+	}{
+		{
+			name: "Valid range",
+			str:  "2024-01-01/2024-01-31",
+			want: DateRange{Date{2024, 1, 1, true}, Date{2024, 1, 31, true}},
+		},
+		{
+			name: "Single day",
+			str:  "2024-02-29/2024-02-29",
+			want: DateRange{Date{2024, 2, 29, true}, Date{2024, 2, 29, true}},
+		},
+		{
+			name:    "Missing separator",
+			str:     "2024-01-01",
+			wantErr: true,
+			offset:  10,
+		},
+		{
+			name:    "Invalid end",
+			str:     "2024-01-01/2024-13-01",
+			wantErr: true,
+			offset:  16,
+		},
+		{
+			name:    "End before start",
+			str:     "2024-01-31/2024-01-01",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDateRange(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			var pe *ParseError
+			if errors.As(err, &pe) && pe.Offset != tt.offset {
+				t.Errorf("expected offset %d, got %d", tt.offset, pe.Offset)
+			}
+		})
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	r := DateRange{Date{2024, 2, 20, true}, Date{2024, 3, 5, true}}
+	if !r.IsValid() {
+		t.Error("expected range to be valid")
+	}
+	if got := r.Days(); got != 15 {
+		t.Errorf("expected 15 days, got %d", got)
+	}
+	for _, tt := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2024, 2, 20, true}, true},
+		{Date{2024, 2, 29, true}, true},
+		{Date{2024, 3, 5, true}, true},
+		{Date{2024, 2, 19, true}, false},
+		{Date{2024, 3, 6, true}, false},
+	} {
+		if got := r.Contains(tt.d); got != tt.want {
+			t.Errorf("Contains(%v): expected %t, got %t", tt.d, tt.want, got)
+		}
+	}
+	for _, tt := range []struct {
+		r2   DateRange
+		want bool
+	}{
+		{DateRange{Date{2024, 3, 5, true}, Date{2024, 3, 9, true}}, true},
+		{DateRange{Date{2024, 1, 1, true}, Date{2024, 12, 31, true}}, true},
+		{DateRange{Date{2024, 3, 6, true}, Date{2024, 3, 9, true}}, false},
+		{DateRange{Date{2024, 2, 1, true}, Date{2024, 2, 19, true}}, false},
+	} {
+		if got := r.Overlaps(tt.r2); got != tt.want {
+			t.Errorf("Overlaps(%v): expected %t, got %t", tt.r2, tt.want, got)
+		}
+	}
+	if _, err := NewDateRange(r.End, r.Start); err == nil {
+		t.Error("expected error for reversed range")
+	}
+	if _, err := NewDateRange(Date{}, r.End); err == nil {
+		t.Error("expected error for invalid start")
+	}
+}
+
+func TestMarshalDateRange(t *testing.T) {
+	type doc struct {
+		R DateRange `json:"r"`
+	}
+	d := doc{DateRange{Date{2024, time.January, 1, true}, Date{2024, time.June, 30, true}}}
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"r":"2024-01-01/2024-06-30"}`; string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+	var back doc
+	if err := json.Unmarshal(b, &back); err != nil || back != d {
+		t.Errorf("expected %v, got %v (error %v)", d, back, err)
+	}
+	if b, err := json.Marshal(doc{}); err != nil || string(b) != `{"r":""}` {
+		t.Errorf("expected empty range, got %s (error %v)", b, err)
+	}
+	if _, err := json.Marshal(doc{DateRange{d.R.End, d.R.Start}}); err == nil {
+		t.Error("expected error for reversed range")
+	}
+}
@@ -0,0 +1,379 @@
+package dt
+
+import (
+	"encoding/binary"
+	"errors"
+	"iter"
+	"math/bits"
+	"slices"
+	"strings"
+	"time"
+)
+
+// A DateSet is a set of dates, stored as a bitmap over day numbers in which
+// only the 64-day words holding at least one date take up space.
+//
+// The zero value is an empty set ready to use. Copying a DateSet shares its
+// contents; use Clone for an independent copy.
+type DateSet struct {
+	words []dateWord // Sorted by key, without empty words.
+}
+
+// A dateWord holds the dates whose Unix days n have n>>6 == key,
+// with bit n&63 set for each date in the set.
+type dateWord struct {
+	key  int
+	bits uint64
+}
+
+// NewDateSet returns the set of dates covered by the given ranges.
+// Ranges that are not valid are ignored.
+func NewDateSet(ranges ...DateRange) DateSet {
+	var s DateSet
+	for _, r := range ranges {
+		s.AddRange(r)
+	}
+	return s
+}
+
+// splitDay returns the word key and bit of the day n.
+func splitDay(n int) (int, uint64) {
+	return n >> 6, 1 << (n & 63)
+}
+
+// find returns the index of the word with key, or where it would be inserted.
+func (s *DateSet) find(key int) (int, bool) {
+	return slices.BinarySearchFunc(s.words, key, func(w dateWord, key int) int {
+		return w.key - key
+	})
+}
+
+// Add adds d to s. Dates that are not valid are ignored.
+func (s *DateSet) Add(d Date) {
+	if !d.IsValid() {
+		return
+	}
+	key, bit := splitDay(d.UnixDays())
+	i, ok := s.find(key)
+	if ok {
+		s.words[i].bits |= bit
+		return
+	}
+	s.words = slices.Insert(s.words, i, dateWord{key, bit})
+}
+
+// Remove removes d from s. Dates that are not valid are ignored.
+func (s *DateSet) Remove(d Date) {
+	if !d.IsValid() {
+		return
+	}
+	key, bit := splitDay(d.UnixDays())
+	if i, ok := s.find(key); ok {
+		if s.words[i].bits &^= bit; s.words[i].bits == 0 {
+			s.words = slices.Delete(s.words, i, i+1)
+		}
+	}
+}
+
+// Contains reports whether d is in s. A date that is not valid is never in s.
+func (s DateSet) Contains(d Date) bool {
+	if !d.IsValid() {
+		return false
+	}
+	key, bit := splitDay(d.UnixDays())
+	i, ok := s.find(key)
+	return ok && s.words[i].bits&bit != 0
+}
+
+// AddRange adds the dates of r to s. A range that is not valid is ignored.
+func (s *DateSet) AddRange(r DateRange) {
+	*s = s.Union(rangeSet(r))
+}
+
+// RemoveRange removes the dates of r from s.
+func (s *DateSet) RemoveRange(r DateRange) {
+	*s = s.Difference(rangeSet(r))
+}
+
+// rangeSet returns the set of dates in r.
+func rangeSet(r DateRange) DateSet {
+	if !r.IsValid() {
+		return DateSet{}
+	}
+	var s DateSet
+	s.appendRun(r.Start.UnixDays(), r.End.UnixDays())
+	return s
+}
+
+// appendRun adds the days first through last to s, which must not hold
+// any day after first.
+func (s *DateSet) appendRun(first, last int) {
+	for key := first >> 6; key <= last>>6; key++ {
+		w := dateWord{key, ^uint64(0)}
+		if key == first>>6 {
+			w.bits <<= first & 63
+		}
+		if key == last>>6 {
+			w.bits &= ^uint64(0) >> (63 - last&63)
+		}
+		if n := len(s.words); n > 0 && s.words[n-1].key == key {
+			s.words[n-1].bits |= w.bits
+			continue
+		}
+		s.words = append(s.words, w)
+	}
+}
+
+// Len returns the number of dates in s.
+func (s DateSet) Len() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w.bits)
+	}
+	return n
+}
+
+// IsEmpty reports whether s holds no dates.
+func (s DateSet) IsEmpty() bool {
+	return len(s.words) == 0
+}
+
+// Equal reports whether s and s2 hold the same dates.
+func (s DateSet) Equal(s2 DateSet) bool {
+	return slices.Equal(s.words, s2.words)
+}
+
+// Clone returns a copy of s that does not share its contents.
+func (s DateSet) Clone() DateSet {
+	return DateSet{words: slices.Clone(s.words)}
+}
+
+// Union returns the dates that are in s, s2 or both.
+func (s DateSet) Union(s2 DateSet) DateSet {
+	return merge(s, s2, func(a, b uint64) uint64 { return a | b }, true, true)
+}
+
+// Intersect returns the dates that are in both s and s2.
+func (s DateSet) Intersect(s2 DateSet) DateSet {
+	return merge(s, s2, func(a, b uint64) uint64 { return a & b }, false, false)
+}
+
+// Difference returns the dates that are in s but not in s2.
+func (s DateSet) Difference(s2 DateSet) DateSet {
+	return merge(s, s2, func(a, b uint64) uint64 { return a &^ b }, true, false)
+}
+
+// merge combines the words of a and b with op. Words found in only one of
+// the sets are kept unchanged if keepA or keepB is set.
+func merge(a, b DateSet, op func(a, b uint64) uint64, keepA, keepB bool) DateSet {
+	var r DateSet
+	i, j := 0, 0
+	for i < len(a.words) || j < len(b.words) {
+		switch {
+		case j == len(b.words) || (i < len(a.words) && a.words[i].key < b.words[j].key):
+			if keepA {
+				r.words = append(r.words, a.words[i])
+			}
+			i++
+		case i == len(a.words) || b.words[j].key < a.words[i].key:
+			if keepB {
+				r.words = append(r.words, b.words[j])
+			}
+			j++
+		default:
+			if w := op(a.words[i].bits, b.words[j].bits); w != 0 {
+				r.words = append(r.words, dateWord{a.words[i].key, w})
+			}
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+// All returns the dates of s in ascending order.
+func (s DateSet) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for _, w := range s.words {
+			for b := w.bits; b != 0; b &= b - 1 {
+				if !yield(DateFromUnixDays(w.key<<6 + bits.TrailingZeros64(b))) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// runs calls f with the first and last Unix day of each maximal run of
+// consecutive dates in s, in ascending order.
+func (s DateSet) runs(f func(first, last int)) {
+	open := false
+	var first, last int
+	for _, w := range s.words {
+		for b := w.bits; b != 0; {
+			lo := bits.TrailingZeros64(b)
+			n := bits.TrailingZeros64(^(b >> lo))
+			start := w.key<<6 + lo
+			if open && last+1 == start {
+				last += n
+			} else {
+				if open {
+					f(first, last)
+				}
+				open, first, last = true, start, start+n-1
+			}
+			b &^= (1<<n - 1) << lo
+		}
+	}
+	if open {
+		f(first, last)
+	}
+}
+
+// Ranges returns the dates of s as the fewest ranges that cover exactly
+// those dates, in ascending order.
+func (s DateSet) Ranges() []DateRange {
+	var rs []DateRange
+	s.runs(func(first, last int) {
+		rs = append(rs, DateRange{DateFromUnixDays(first), DateFromUnixDays(last)})
+	})
+	return rs
+}
+
+// ParseDateSet parses a comma-separated list of dates and date ranges,
+// such as "2024-05-01/2024-05-10,2024-05-15". An empty string is the empty set.
+// Failures are reported as a *ParseError.
+func ParseDateSet(s string) (DateSet, error) {
+	var set DateSet
+	if s == "" {
+		return set, nil
+	}
+	offset := 0
+	for _, part := range strings.Split(s, ",") {
+		var err error
+		if strings.Contains(part, "/") {
+			var r DateRange
+			if r, err = ParseDateRange(part); err == nil {
+				set.AddRange(r)
+			}
+		} else {
+			var d Date
+			if d, err = ParseDate(part); err == nil {
+				set.Add(d)
+			}
+		}
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				pe.Type, pe.Input, pe.Offset = "date set", s, pe.Offset+offset
+				pe.Expected = []string{"YYYY-MM-DD", "YYYY-MM-DD/YYYY-MM-DD"}
+			}
+			return DateSet{}, err
+		}
+		offset += len(part) + 1
+	}
+	return set, nil
+}
+
+// String returns the dates of s as a comma-separated list of its ranges, with
+// ranges of a single day written as a date, e.g. "2024-05-01/2024-05-10,2024-05-15".
+func (s DateSet) String() string {
+	var b strings.Builder
+	s.runs(func(first, last int) {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(DateFromUnixDays(first).String())
+		if last != first {
+			b.WriteByte('/')
+			b.WriteString(DateFromUnixDays(last).String())
+		}
+	})
+	return b.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of s.String().
+func (s DateSet) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The set is expected to be in a format accepted by ParseDateSet.
+func (s *DateSet) UnmarshalText(data []byte) error {
+	var err error
+	*s, err = ParseDateSet(string(data))
+	return err
+}
+
+// dateSetVersion is the first byte of the binary encoding of a DateSet.
+const dateSetVersion = 1
+
+// The binary encoding of a DateSet holds the dates of years 1 through 9999.
+var (
+	minBinaryDay = Date{1, time.January, 1, true}.UnixDays()
+	maxBinaryDay = Date{9999, time.December, 31, true}.UnixDays()
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The set is encoded as a version byte followed by a pair of varints for each
+// of its ranges: the distance of the first day from the end of the previous
+// range, or from 1970-01-01 for the first range, and the length of the range.
+// Sets holding dates outside of years 1 through 9999 cannot be encoded.
+func (s DateSet) MarshalBinary() ([]byte, error) {
+	if !s.IsEmpty() {
+		if first, last := s.bounds(); first < minBinaryDay || last > maxBinaryDay {
+			return nil, errors.New("dt: DateSet holds dates outside of years 1 to 9999")
+		}
+	}
+	b := []byte{dateSetVersion}
+	prev := 0
+	s.runs(func(first, last int) {
+		b = binary.AppendVarint(b, int64(first-prev))
+		b = binary.AppendUvarint(b, uint64(last-first+1))
+		prev = last
+	})
+	return b, nil
+}
+
+// bounds returns the first and last Unix day in s, which must not be empty.
+func (s DateSet) bounds() (int, int) {
+	lo, hi := s.words[0], s.words[len(s.words)-1]
+	return lo.key<<6 + bits.TrailingZeros64(lo.bits), hi.key<<6 + 63 - bits.LeadingZeros64(hi.bits)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Dates outside of years 1 through 9999 are rejected.
+func (s *DateSet) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != dateSetVersion {
+		return errors.New("dt: unsupported DateSet encoding")
+	}
+	errInvalid := errors.New("dt: invalid DateSet encoding")
+	var set DateSet
+	prev := 0
+	for b := data[1:]; len(b) > 0; {
+		delta, n := binary.Varint(b)
+		if n <= 0 {
+			return errInvalid
+		}
+		b = b[n:]
+		length, n := binary.Uvarint(b)
+		// Ranges must be ascending and separated by at least one day.
+		if n <= 0 || length == 0 || (!set.IsEmpty() && delta < 2) {
+			return errInvalid
+		}
+		b = b[n:]
+		// Both bounds are far from overflowing int64, and prev is within them.
+		if delta < int64(minBinaryDay-prev) || delta > int64(maxBinaryDay-prev) {
+			return errInvalid
+		}
+		first := prev + int(delta)
+		if length > uint64(maxBinaryDay-first+1) {
+			return errInvalid
+		}
+		prev = first + int(length) - 1
+		set.appendRun(first, prev)
+	}
+	*s = set
+	return nil
+}
@@ -0,0 +1,248 @@
+package dt
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDateSet(t *testing.T) {
+	var s DateSet
+	s.AddRange(DateRange{Date{2024, time.May, 1, true}, Date{2024, time.May, 10, true}})
+	s.Add(Date{2024, time.May, 15, true})
+	s.Add(Date{2024, time.May, 11, true})
+	s.Add(Date{1969, time.December, 31, true})
+	s.Add(Date{2023, time.February, 29, true})
+	s.Add(Date{})
+	s.Remove(Date{2024, time.May, 5, true})
+	s.Remove(Date{2024, time.April, 31, true})
+
+	if got, want := s.String(), "1969-12-31,2024-05-01/2024-05-04,2024-05-06/2024-05-11,2024-05-15"; got != want {
+		t.Errorf("String(): got %q, want %q", got, want)
+	}
+	if got := s.Len(); got != 12 {
+		t.Errorf("Len(): got %d, want 12", got)
+	}
+	for _, tt := range []struct {
+		d    Date
+		want bool
+	}{
+		{Date{2024, time.May, 1, true}, true},
+		{Date{2024, time.May, 5, true}, false},
+		{Date{2024, time.May, 15, true}, true},
+		{Date{1969, time.December, 31, true}, true},
+		{Date{1970, time.January, 1, true}, false},
+		{Date{2024, time.April, 31, true}, false},
+		{Date{}, false},
+	} {
+		if got := s.Contains(tt.d); got != tt.want {
+			t.Errorf("Contains(%v): got %t, want %t", tt.d, got, tt.want)
+		}
+	}
+
+	dates := slices.Collect(s.All())
+	if len(dates) != 12 || dates[0] != (Date{1969, time.December, 31, true}) || dates[11] != (Date{2024, time.May, 15, true}) {
+		t.Errorf("All(): got %v", dates)
+	}
+	if rs := s.Ranges(); len(rs) != 4 || rs[2] != (DateRange{Date{2024, time.May, 6, true}, Date{2024, time.May, 11, true}}) {
+		t.Errorf("Ranges(): got %v", rs)
+	}
+	if got := NewDateSet(s.Ranges()...); !got.Equal(s) {
+		t.Errorf("NewDateSet(Ranges()): got %v, want %v", got, s)
+	}
+
+	c := s.Clone()
+	c.RemoveRange(DateRange{Date{2024, time.January, 1, true}, Date{2024, time.December, 31, true}})
+	if c.String() != "1969-12-31" || s.Len() != 12 {
+		t.Errorf("RemoveRange on clone: got %v, original %v", c, s)
+	}
+	c.Remove(Date{1969, time.December, 31, true})
+	if !c.IsEmpty() || c.String() != "" {
+		t.Errorf("expected empty set, got %v", c)
+	}
+}
+
+func TestDateSetAlgebra(t *testing.T) {
+	set := func(s string) DateSet {
+		t.Helper()
+		ds, err := ParseDateSet(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ds
+	}
+	a := set("2024-01-01/2024-03-31,2024-06-01")
+	b := set("2024-03-01/2024-06-30")
+	for _, tt := range []struct {
+		name string
+		got  DateSet
+		want string
+	}{
+		{"union", a.Union(b), "2024-01-01/2024-06-30"},
+		{"intersect", a.Intersect(b), "2024-03-01/2024-03-31,2024-06-01"},
+		{"difference", a.Difference(b), "2024-01-01/2024-02-29"},
+		{"reverse difference", b.Difference(a), "2024-04-01/2024-05-31,2024-06-02/2024-06-30"},
+		{"empty", a.Intersect(DateSet{}), ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateSetMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	base := Date{2023, time.December, 1, true}
+	var s1, s2 DateSet
+	m1, m2 := map[int]bool{}, map[int]bool{}
+	for i := 0; i < 2000; i++ {
+		s, m := &s1, m1
+		if r.IntN(2) == 0 {
+			s, m = &s2, m2
+		}
+		n := r.IntN(400) - 100
+		switch r.IntN(4) {
+		case 0:
+			s.Add(base.AddDays(n))
+			m[n] = true
+		case 1:
+			s.Remove(base.AddDays(n))
+			delete(m, n)
+		case 2:
+			l := r.IntN(90)
+			s.AddRange(DateRange{base.AddDays(n), base.AddDays(n + l)})
+			for j := n; j <= n+l; j++ {
+				m[j] = true
+			}
+		case 3:
+			l := r.IntN(90)
+			s.RemoveRange(DateRange{base.AddDays(n), base.AddDays(n + l)})
+			for j := n; j <= n+l; j++ {
+				delete(m, j)
+			}
+		}
+	}
+	union, inter, diff := s1.Union(s2), s1.Intersect(s2), s1.Difference(s2)
+	for n := -200; n < 500; n++ {
+		d := base.AddDays(n)
+		if s1.Contains(d) != m1[n] || union.Contains(d) != (m1[n] || m2[n]) ||
+			inter.Contains(d) != (m1[n] && m2[n]) || diff.Contains(d) != (m1[n] && !m2[n]) {
+			t.Fatalf("%v: set operations disagree with maps", d)
+		}
+	}
+	if s1.Len() != len(m1) {
+		t.Errorf("Len(): got %d, want %d", s1.Len(), len(m1))
+	}
+	var enc DateSet
+	if err := enc.UnmarshalText([]byte(s1.String())); err != nil || !enc.Equal(s1) {
+		t.Errorf("text round trip: got %v (error %v), want %v", enc, err, s1)
+	}
+}
+
+func TestParseDateSetErrors(t *testing.T) {
+	for _, tt := range []struct {
+		in     string
+		offset int
+	}{
+		{"2024-05-01,2024-05-32", 19},
+		{"2024-05-01/2024-04-01", 0},
+		{"2024-05-01,x", 11},
+	} {
+		_, err := ParseDateSet(tt.in)
+		var pe *ParseError
+		if err == nil {
+			t.Errorf("ParseDateSet(%q): expected error", tt.in)
+		} else if errors.As(err, &pe) && (pe.Type != "date set" || pe.Offset != tt.offset) {
+			t.Errorf("ParseDateSet(%q): got %v at offset %d, want offset %d", tt.in, pe.Type, pe.Offset, tt.offset)
+		}
+	}
+}
+
+func TestDateSetEncoding(t *testing.T) {
+	s := NewDateSet(
+		DateRange{Date{1, time.March, 1, true}, Date{1, time.March, 1, true}},
+		DateRange{Date{2024, time.May, 1, true}, Date{2025, time.April, 30, true}},
+		DateRange{Date{2025, time.June, 1, true}, Date{2025, time.June, 2, true}},
+	)
+	b, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) > 12 {
+		t.Errorf("expected a compact encoding, got %d bytes", len(b))
+	}
+	var got DateSet
+	if err := got.UnmarshalBinary(b); err != nil || !got.Equal(s) {
+		t.Errorf("binary round trip: got %v (error %v), want %v", got, err, s)
+	}
+	for _, bad := range [][]byte{
+		nil, {2}, {1, 0x80}, {1, 2, 0}, {1, 2, 2, 0, 1},
+		binary.AppendUvarint(binary.AppendVarint([]byte{1}, -719163), 1),             // 0000-12-31
+		binary.AppendUvarint(binary.AppendVarint([]byte{1}, 2932897), 1),             // 10000-01-01
+		binary.AppendUvarint(binary.AppendVarint([]byte{1}, 2932896), 2),             // 9999-12-31 and the day after
+		binary.AppendUvarint(binary.AppendVarint([]byte{1}, 0), math.MaxUint64),      // overflowing length
+		binary.AppendUvarint(binary.AppendVarint([]byte{1, 0, 1}, math.MaxInt64), 1), // overflowing delta
+	} {
+		if err := got.UnmarshalBinary(bad); err == nil {
+			t.Errorf("UnmarshalBinary(%v): expected error", bad)
+		}
+	}
+	if _, err := NewDateSet(DateRange{Date{-500, time.March, 1, true}, Date{-500, time.March, 1, true}}).MarshalBinary(); err == nil {
+		t.Error("MarshalBinary: expected error for year -500")
+	}
+
+	j, err := json.Marshal(struct{ Free DateSet }{s})
+	if err != nil || string(j) != `{"Free":"0001-03-01,2024-05-01/2025-04-30,2025-06-01/2025-06-02"}` {
+		t.Errorf("unexpected JSON %s (error %v)", j, err)
+	}
+}
+
+func FuzzDateSetUnmarshalBinary(f *testing.F) {
+	s := NewDateSet(
+		DateRange{Date{1, time.January, 1, true}, Date{1, time.January, 3, true}},
+		DateRange{Date{2024, time.May, 1, true}, Date{2025, time.April, 30, true}},
+		DateRange{Date{9999, time.December, 31, true}, Date{9999, time.December, 31, true}},
+	)
+	b, _ := s.MarshalBinary()
+	f.Add(b)
+	f.Add([]byte{1})
+	f.Add([]byte{1, 2, 2, 0, 1})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var s DateSet
+		if err := s.UnmarshalBinary(data); err != nil {
+			return
+		}
+		b, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary after UnmarshalBinary(%v): %v", data, err)
+		}
+		var got DateSet
+		if err := got.UnmarshalBinary(b); err != nil || !got.Equal(s) {
+			t.Fatalf("round trip of %v: got %v (error %v), want %v", data, got, err, s)
+		}
+		for d := range s.All() {
+			if !d.IsValid() || d.Year < 1 || d.Year > 9999 {
+				t.Fatalf("UnmarshalBinary(%v): got date %v", data, d)
+			}
+		}
+	})
+}
+
+func BenchmarkDateSetUnion(b *testing.B) {
+	var s1, s2 DateSet
+	start := Date{2024, time.January, 1, true}
+	for i := 0; i < 3650; i += 3 {
+		s1.Add(start.AddDays(i))
+		s2.Add(start.AddDays(i + 1))
+	}
+	for i := 0; i < b.N; i++ {
+		s1.Union(s2)
+	}
+}
@@ -16,6 +16,7 @@
 package dt
 
 import (
+	"cmp"
 	"database/sql/driver"
 	"fmt"
 	"time"
@@ -37,6 +38,20 @@ func DateTimeOf(t time.Time) DateTime {
 	}
 }
 
+// NewDateTime returns the DateTime for the given date and time of day.
+// It returns a *RangeError if the values do not form a valid DateTime.
+func NewDateTime(year int, month time.Month, day, hour, minute int) (DateTime, error) {
+	d, err := NewDate(year, month, day)
+	if err != nil {
+		return DateTime{}, err
+	}
+	t, err := NewTime(hour, minute)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: t}, nil
+}
+
 var dtFormats = []string{"2006-01-02T15:04", "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04"}
 
 // ParseDateTime parses a string and returns the DateTime it represents.
@@ -46,17 +61,10 @@ var dtFormats = []string{"2006-01-02T15:04", "2006-01-02T15:04:05", "2006-01-02
 //
 //	YYYY-MM-DDTHH:MM:SS[.FFFFFFFFF]
 //
-// where the 'T' may be a lower-case 't'.
+// where the 'T' may be a lower-case 't'. Failures are reported as a *ParseError
+// for the format that matched the longest prefix of s.
 func ParseDateTime(s string) (DateTime, error) {
-	var t time.Time
-	var err error
-	for _, f := range dtFormats {
-		t, err = time.Parse(f, s)
-		if err == nil {
-			break
-		}
-	}
-
+	t, err := parseLayouts("datetime", s, dtFormats...)
 	if err != nil {
 		return DateTime{}, err
 	}
@@ -71,6 +79,35 @@ func (dt DateTime) String() string {
 	return ""
 }
 
+// IsValid reports whether both the date and time of dt are valid.
+func (dt DateTime) IsValid() bool {
+	return dt.Date.IsValid() && dt.Time.IsValid()
+}
+
+// Normalize returns dt with out-of-range fields carried over, so that
+// for example 2023-12-31T24:30 normalizes to 2024-01-01T00:30.
+func (dt DateTime) Normalize() DateTime {
+	days, t := dt.Time.normalize()
+	d := dt.Date
+	if d.Valid {
+		d = d.AddDays(days)
+	}
+	return DateTime{Date: d, Time: t}
+}
+
+// check returns a *RangeError describing the first out-of-range field of dt.
+func (dt DateTime) check() error {
+	if dt.Date.Valid {
+		if err := dt.Date.check(); err != nil {
+			return err
+		}
+	}
+	if dt.Time.Valid {
+		return dt.Time.check()
+	}
+	return nil
+}
+
 // In returns the time corresponding to the DateTime in the given location.
 //
 // If the time is missing or ambigous at the location, In returns the same
@@ -94,12 +131,16 @@ func (dt DateTime) In(loc *time.Location) time.Time {
 
 // Before reports whether dt occurs before dt2.
 func (dt DateTime) Before(dt2 DateTime) bool {
-	return dt.In(time.UTC).Before(dt2.In(time.UTC))
+	return dt.unixMinutes() < dt2.unixMinutes()
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
-// The output is the result of dt.String().
+// The output is the result of dt.String(). A DateTime holding an
+// out-of-range field results in a *RangeError.
 func (dt DateTime) MarshalText() ([]byte, error) {
+	if err := dt.check(); err != nil {
+		return nil, err
+	}
 	return []byte(dt.String()), nil
 }
 
@@ -113,6 +154,9 @@ func (dt *DateTime) UnmarshalText(data []byte) error {
 
 // Value implements valuer interface
 func (dt DateTime) Value() (driver.Value, error) {
+	if err := dt.check(); err != nil {
+		return nil, err
+	}
 	if dt.Date.Valid && dt.Time.Valid {
 		return driver.Value(dt.String()), nil
 	}
@@ -146,5 +190,17 @@ func (dt *DateTime) Scan(value interface{}) error {
 // Compare compares dt and dt2. If dt is before dt2, it returns -1;
 // if dt is after dt2, it returns +1; otherwise it returns 0.
 func (dt DateTime) Compare(dt2 DateTime) int {
-	return dt.In(time.UTC).Compare(dt2.In(time.UTC))
+	return cmp.Compare(dt.unixMinutes(), dt2.unixMinutes())
+}
+
+// unixMinutes returns the number of minutes between 1970-01-01T00:00 and dt.
+func (dt DateTime) unixMinutes() int {
+	return dt.Date.UnixDays()*minutesPerDay + dt.Time.Hour*60 + dt.Time.Minute
+}
+
+// dateTimeFromUnixMinutes returns the DateTime n minutes after 1970-01-01T00:00.
+func dateTimeFromUnixMinutes(n int) DateTime {
+	days := floorDiv(n, minutesPerDay)
+	n -= days * minutesPerDay
+	return DateTime{Date: DateFromUnixDays(days), Time: Time{Hour: n / 60, Minute: n % 60, Valid: true}}
 }
@@ -2,6 +2,8 @@ package dt
 
 import (
 	"encoding/json"
+	"errors"
+	"math/rand/v2"
 	"testing"
 	"time"
 )
@@ -281,3 +283,99 @@ func TestDateTimeCompare(t *testing.T) {
 		}
 	}
 }
+
+func TestNewDateTime(t *testing.T) {
+	got, err := NewDateTime(2024, 2, 29, 13, 45)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (DateTime{Date{2024, 2, 29, true}, Time{13, 45, true}}); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	var rerr *RangeError
+	if _, err := NewDateTime(2023, 2, 29, 13, 45); !errors.As(err, &rerr) || rerr.Field != "day" {
+		t.Errorf("expected day RangeError, got %v", err)
+	}
+	if _, err := NewDateTime(2024, 2, 29, 13, 60); !errors.As(err, &rerr) || rerr.Field != "minute" {
+		t.Errorf("expected minute RangeError, got %v", err)
+	}
+}
+
+func TestDateTimeIsValid(t *testing.T) {
+	for _, tt := range []struct {
+		dt   DateTime
+		want bool
+	}{
+		{DateTime{Date{2024, 2, 29, true}, Time{13, 45, true}}, true},
+		{DateTime{Date{2024, 2, 30, true}, Time{13, 45, true}}, false},
+		{DateTime{Date{2024, 2, 29, true}, Time{24, 0, true}}, false},
+		{DateTime{Date{2024, 2, 29, true}, Time{}}, false},
+	} {
+		if got := tt.dt.IsValid(); got != tt.want {
+			t.Errorf("%#v.IsValid(): got %t, want %t", tt.dt, got, tt.want)
+		}
+	}
+}
+
+func TestDateTimeNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		dt, want DateTime
+	}{
+		{
+			DateTime{Date{2023, 12, 31, true}, Time{24, 30, true}},
+			DateTime{Date{2024, 1, 1, true}, Time{0, 30, true}},
+		},
+		{
+			DateTime{Date{2024, 3, 1, true}, Time{0, -1, true}},
+			DateTime{Date{2024, 2, 29, true}, Time{23, 59, true}},
+		},
+		{
+			DateTime{Date{2024, 2, 30, true}, Time{12, 0, true}},
+			DateTime{Date{2024, 3, 1, true}, Time{12, 0, true}},
+		},
+	} {
+		if got := tt.dt.Normalize(); got != tt.want {
+			t.Errorf("%#v.Normalize(): got %v, want %v", tt.dt, got, tt.want)
+		}
+	}
+}
+
+func TestDateTimeRejectOutOfRange(t *testing.T) {
+	dt := DateTime{Date{2024, 2, 29, true}, Time{24, 0, true}}
+	var rerr *RangeError
+	if _, err := dt.Value(); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from Value, got %v", err)
+	}
+	if _, err := dt.MarshalText(); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from MarshalText, got %v", err)
+	}
+}
+
+func TestDateTimeMatchesTime(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for i := 0; i < 10000; i++ {
+		dt1 := DateTime{randomDate(r), Time{r.IntN(24), r.IntN(60), true}}
+		dt2 := dt1
+		if r.IntN(2) == 0 {
+			dt2 = DateTime{randomDate(r), Time{r.IntN(24), r.IntN(60), true}}
+		} else {
+			dt2.Time = Time{r.IntN(24), r.IntN(60), true}
+		}
+		t1, t2 := dt1.In(time.UTC), dt2.In(time.UTC)
+		if got, want := dt1.Compare(dt2), t1.Compare(t2); got != want {
+			t.Fatalf("%v.Compare(%v): got %d, want %d", dt1, dt2, got, want)
+		}
+		if got, want := dt1.Before(dt2), t1.Before(t2); got != want {
+			t.Fatalf("%v.Before(%v): got %t, want %t", dt1, dt2, got, want)
+		}
+	}
+}
+
+func BenchmarkDateTimeCompare(b *testing.B) {
+	dt1 := DateTime{Date{2024, time.May, 15, true}, Time{14, 30, true}}
+	dt2 := DateTime{Date{2024, time.May, 15, true}, Time{9, 0, true}}
+	for i := 0; i < b.N; i++ {
+		dt1.Compare(dt2)
+	}
+}
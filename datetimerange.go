@@ -0,0 +1,128 @@
+package dt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A DateTimeRange represents the span of time from Start up to, but not
+// including, End.
+type DateTimeRange struct {
+	Start DateTime
+	End   DateTime
+}
+
+// NewDateTimeRange returns the range from start up to end.
+// It returns an error if either end is not valid or end is before start.
+func NewDateTimeRange(start, end DateTime) (DateTimeRange, error) {
+	r := DateTimeRange{Start: start, End: end}
+	if err := r.check(); err != nil {
+		return DateTimeRange{}, err
+	}
+	return r, nil
+}
+
+// ParseDateTimeRange parses a string in the ISO 8601 interval format
+// YYYY-MM-DDTHH:mm/YYYY-MM-DDTHH:mm and returns the range it represents.
+// Both ends accept the formats of ParseDateTime.
+// Failures are reported as a *ParseError.
+func ParseDateTimeRange(s string) (DateTimeRange, error) {
+	const expected = "YYYY-MM-DDThh:mm/YYYY-MM-DDThh:mm"
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return DateTimeRange{}, &ParseError{Type: "datetime range", Input: s, Expected: []string{expected}, Offset: len(s)}
+	}
+	var r DateTimeRange
+	for _, p := range []struct {
+		dt     *DateTime
+		s      string
+		offset int
+	}{{&r.Start, start, 0}, {&r.End, end, len(start) + 1}} {
+		dt, err := ParseDateTime(p.s)
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				pe.Type, pe.Input, pe.Offset = "datetime range", s, pe.Offset+p.offset
+				pe.Expected = []string{expected}
+			}
+			return DateTimeRange{}, err
+		}
+		*p.dt = dt
+	}
+	if err := r.check(); err != nil {
+		return DateTimeRange{}, err
+	}
+	return r, nil
+}
+
+// String returns the range in the ISO 8601 interval format
+// YYYY-MM-DDTHH:mm/YYYY-MM-DDTHH:mm.
+func (r DateTimeRange) String() string {
+	if r.Start.String() == "" || r.End.String() == "" {
+		return ""
+	}
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// IsValid reports whether both ends of r are valid and End is not before Start.
+func (r DateTimeRange) IsValid() bool {
+	return r.check() == nil
+}
+
+// check returns an error if r is not valid.
+func (r DateTimeRange) check() error {
+	for _, dt := range []DateTime{r.Start, r.End} {
+		if !dt.Date.Valid || !dt.Time.Valid {
+			return errors.New("dt: datetime range requires valid start and end")
+		}
+		if err := dt.check(); err != nil {
+			return err
+		}
+	}
+	if r.End.Before(r.Start) {
+		return fmt.Errorf("dt: datetime range end %v is before start %v", r.End, r.Start)
+	}
+	return nil
+}
+
+// IsEmpty reports whether r contains no time, because End is not after Start.
+func (r DateTimeRange) IsEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Duration returns the length of r.
+func (r DateTimeRange) Duration() time.Duration {
+	return time.Duration(r.End.unixMinutes()-r.Start.unixMinutes()) * time.Minute
+}
+
+// Contains reports whether dt falls within r.
+func (r DateTimeRange) Contains(dt DateTime) bool {
+	return !dt.Before(r.Start) && dt.Before(r.End)
+}
+
+// Overlaps reports whether r and r2 share any time. Empty ranges overlap nothing.
+func (r DateTimeRange) Overlaps(r2 DateTimeRange) bool {
+	return !r.IsEmpty() && !r2.IsEmpty() && r.Start.Before(r2.End) && r2.Start.Before(r.End)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String(). A range that has valid ends
+// but is otherwise not valid results in an error.
+func (r DateTimeRange) MarshalText() ([]byte, error) {
+	if r.String() != "" {
+		if err := r.check(); err != nil {
+			return nil, err
+		}
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The range is expected to be a string in a format accepted by ParseDateTimeRange.
+func (r *DateTimeRange) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseDateTimeRange(string(data))
+	return err
+}
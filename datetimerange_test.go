@@ -0,0 +1,132 @@
+package dt
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDateTimeRange(t *testing.T) {
+	cases := []struct {
+		name    string
+		str     string
+		want    DateTimeRange
+		wantErr bool
+		offset  int
+	}{
+		{
+			name: "Valid range",
+			str:  "2024-05-15T09:00/2024-05-15T17:30",
+			want: DateTimeRange{DateTime{Date{2024, 5, 15, true}, Time{9, 0, true}}, DateTime{Date{2024, 5, 15, true}, Time{17, 30, true}}},
+		},
+		{
+			name: "Empty range",
+			str:  "2024-05-15T09:00/2024-05-15T09:00",
+			want: DateTimeRange{DateTime{Date{2024, 5, 15, true}, Time{9, 0, true}}, DateTime{Date{2024, 5, 15, true}, Time{9, 0, true}}},
+		},
+		{
+			name:    "Missing separator",
+			str:     "2024-05-15T09:00",
+			wantErr: true,
+			offset:  16,
+		},
+		{
+			name:    "Invalid end",
+			str:     "2024-05-15T09:00/2024-05-15T25:00",
+			wantErr: true,
+			offset:  28,
+		},
+		{
+			name:    "End before start",
+			str:     "2024-05-15T09:00/2024-05-14T09:00",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDateTimeRange(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			var pe *ParseError
+			if errors.As(err, &pe) && pe.Offset != tt.offset {
+				t.Errorf("expected offset %d, got %d", tt.offset, pe.Offset)
+			}
+		})
+	}
+}
+
+func TestDateTimeRange(t *testing.T) {
+	at := func(day, hour, minute int) DateTime {
+		return DateTime{Date{2024, 5, day, true}, Time{hour, minute, true}}
+	}
+	r := DateTimeRange{at(15, 22, 0), at(16, 1, 30)}
+	if !r.IsValid() || r.IsEmpty() {
+		t.Error("expected range to be valid and not empty")
+	}
+	if got := r.Duration(); got != 3*time.Hour+30*time.Minute {
+		t.Errorf("expected 3h30m, got %v", got)
+	}
+	for _, tt := range []struct {
+		dt   DateTime
+		want bool
+	}{
+		{at(15, 22, 0), true},
+		{at(16, 0, 0), true},
+		{at(16, 1, 29), true},
+		{at(16, 1, 30), false},
+		{at(15, 21, 59), false},
+	} {
+		if got := r.Contains(tt.dt); got != tt.want {
+			t.Errorf("Contains(%v): expected %t, got %t", tt.dt, tt.want, got)
+		}
+	}
+	for _, tt := range []struct {
+		r2   DateTimeRange
+		want bool
+	}{
+		{DateTimeRange{at(16, 1, 0), at(16, 2, 0)}, true},
+		{DateTimeRange{at(15, 0, 0), at(17, 0, 0)}, true},
+		{DateTimeRange{at(16, 1, 30), at(16, 2, 0)}, false},
+		{DateTimeRange{at(15, 21, 0), at(15, 22, 0)}, false},
+		{DateTimeRange{at(15, 23, 0), at(15, 23, 0)}, false},
+	} {
+		if got := r.Overlaps(tt.r2); got != tt.want {
+			t.Errorf("Overlaps(%v): expected %t, got %t", tt.r2, tt.want, got)
+		}
+	}
+	if _, err := NewDateTimeRange(r.End, r.Start); err == nil {
+		t.Error("expected error for reversed range")
+	}
+	if _, err := NewDateTimeRange(DateTime{}, r.End); err == nil {
+		t.Error("expected error for invalid start")
+	}
+}
+
+func TestMarshalDateTimeRange(t *testing.T) {
+	type doc struct {
+		R DateTimeRange `json:"r"`
+	}
+	d := doc{DateTimeRange{DateTime{Date{2024, time.May, 15, true}, Time{9, 0, true}}, DateTime{Date{2024, time.May, 15, true}, Time{10, 0, true}}}}
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"r":"2024-05-15T09:00/2024-05-15T10:00"}`; string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+	var back doc
+	if err := json.Unmarshal(b, &back); err != nil || back != d {
+		t.Errorf("expected %v, got %v (error %v)", d, back, err)
+	}
+	if b, err := json.Marshal(doc{}); err != nil || string(b) != `{"r":""}` {
+		t.Errorf("expected empty range, got %s (error %v)", b, err)
+	}
+	if _, err := json.Marshal(doc{DateTimeRange{d.R.End, d.R.Start}}); err == nil {
+		t.Error("expected error for reversed range")
+	}
+}
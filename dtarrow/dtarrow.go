@@ -0,0 +1,232 @@
+// Package dtarrow converts between dt types and Apache Arrow temporal types,
+// which back the DATE, TIME and TIMESTAMP logical types of Parquet files.
+//
+//   - dt.Date maps to Date32, the number of days since the Unix epoch.
+//   - dt.Time maps to Time32 in seconds or milliseconds, and to Time64 in
+//     microseconds or nanoseconds, counting units since midnight.
+//   - dt.DateTime maps to a Timestamp without a time zone, which corresponds
+//     to a Parquet TIMESTAMP with isAdjustedToUTC=false.
+//
+// Values that are not Valid map to nulls in arrays, and nulls map to values
+// that are not Valid. Dates and timestamps that do not fit the 32 or 64 bits
+// of their Arrow type are rejected with a *dt.RangeError: Date32 covers about
+// 5.8 million years around 1970, and nanosecond timestamps the years 1677
+// to 2262.
+package dtarrow
+
+import (
+	"math"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/ribice/dt"
+)
+
+// ToDate32 returns the number of days between the Unix epoch and d.
+// It fails if the number does not fit in 32 bits.
+func ToDate32(d dt.Date) (arrow.Date32, error) {
+	days := d.UnixDays()
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return 0, &dt.RangeError{Field: "year", Value: d.Year}
+	}
+	return arrow.Date32(days), nil
+}
+
+// FromDate32 returns the Date that is v days after the Unix epoch.
+func FromDate32(v arrow.Date32) dt.Date {
+	return dt.DateFromUnixDays(int(v))
+}
+
+// ToTime32 returns the number of units since midnight of t.
+// unit must be arrow.Second or arrow.Millisecond.
+func ToTime32(t dt.Time, unit arrow.TimeUnit) arrow.Time32 {
+	return arrow.Time32(sinceMidnight(t) / unit.Multiplier())
+}
+
+// FromTime32 returns the Time of day v units after midnight.
+// Seconds and fractions of seconds are truncated. If v is negative or not
+// less than 24 hours, the returned Time is not Valid.
+func FromTime32(v arrow.Time32, unit arrow.TimeUnit) dt.Time {
+	return timeOfUnits(int64(v), unit)
+}
+
+// ToTime64 returns the number of units since midnight of t.
+// unit must be arrow.Microsecond or arrow.Nanosecond.
+func ToTime64(t dt.Time, unit arrow.TimeUnit) arrow.Time64 {
+	return arrow.Time64(sinceMidnight(t) / unit.Multiplier())
+}
+
+// FromTime64 returns the Time of day v units after midnight.
+// Seconds and fractions of seconds are truncated. If v is negative or not
+// less than 24 hours, the returned Time is not Valid.
+func FromTime64(v arrow.Time64, unit arrow.TimeUnit) dt.Time {
+	return timeOfUnits(int64(v), unit)
+}
+
+// ToTimestamp returns the number of units between the Unix epoch and d,
+// treating d as wall clock time without a time zone. It fails if the number
+// does not fit in 64 bits.
+func ToTimestamp(d dt.DateTime, unit arrow.TimeUnit) (arrow.Timestamp, error) {
+	days := int64(d.Date.UnixDays())
+	perDay := int64(24 * time.Hour / unit.Multiplier())
+	rem := int64(sinceMidnight(d.Time) / unit.Multiplier())
+	// Division truncates toward zero, so these are the first and last days
+	// whose units fit. The first day is shifted by one so that the bound
+	// accounts for rem without overflowing.
+	if days+1 < (math.MinInt64+perDay-rem)/perDay || days > (math.MaxInt64-rem)/perDay {
+		return 0, &dt.RangeError{Field: "year", Value: d.Date.Year}
+	}
+	return arrow.Timestamp(days*perDay + rem), nil
+}
+
+// FromTimestamp returns the DateTime v units after the Unix epoch.
+// Seconds and fractions of seconds are truncated.
+func FromTimestamp(v arrow.Timestamp, unit arrow.TimeUnit) dt.DateTime {
+	perDay := int64(24 * time.Hour / unit.Multiplier())
+	days, rem := int64(v)/perDay, int64(v)%perDay
+	if rem < 0 {
+		days--
+		rem += perDay
+	}
+	return dt.DateTime{
+		Date: dt.DateFromUnixDays(int(days)),
+		Time: timeOf(time.Duration(rem) * unit.Multiplier()),
+	}
+}
+
+// NewDate32Array returns a Date32 array holding ds. It fails if ToDate32
+// fails for any of them.
+func NewDate32Array(mem memory.Allocator, ds []dt.Date) (*array.Date32, error) {
+	values := make([]arrow.Date32, len(ds))
+	valid := make([]bool, len(ds))
+	for i, d := range ds {
+		if valid[i] = d.Valid; d.Valid {
+			var err error
+			if values[i], err = ToDate32(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b := array.NewDate32Builder(mem)
+	defer b.Release()
+	b.AppendValues(values, valid)
+	return b.NewDate32Array(), nil
+}
+
+// Dates returns the values of a as Dates.
+func Dates(a *array.Date32) []dt.Date {
+	ds := make([]dt.Date, a.Len())
+	for i, v := range a.Date32Values() {
+		if a.IsValid(i) {
+			ds[i] = FromDate32(v)
+		}
+	}
+	return ds
+}
+
+// NewTime32Array returns a Time32 array of the given unit holding ts.
+// unit must be arrow.Second or arrow.Millisecond.
+func NewTime32Array(mem memory.Allocator, ts []dt.Time, unit arrow.TimeUnit) *array.Time32 {
+	values := make([]arrow.Time32, len(ts))
+	valid := make([]bool, len(ts))
+	for i, t := range ts {
+		if valid[i] = t.Valid; t.Valid {
+			values[i] = ToTime32(t, unit)
+		}
+	}
+	b := array.NewTime32Builder(mem, &arrow.Time32Type{Unit: unit})
+	defer b.Release()
+	b.AppendValues(values, valid)
+	return b.NewTime32Array()
+}
+
+// Times32 returns the values of a as Times.
+func Times32(a *array.Time32) []dt.Time {
+	unit := a.DataType().(*arrow.Time32Type).Unit
+	ts := make([]dt.Time, a.Len())
+	for i, v := range a.Time32Values() {
+		if a.IsValid(i) {
+			ts[i] = FromTime32(v, unit)
+		}
+	}
+	return ts
+}
+
+// NewTime64Array returns a Time64 array of the given unit holding ts.
+// unit must be arrow.Microsecond or arrow.Nanosecond.
+func NewTime64Array(mem memory.Allocator, ts []dt.Time, unit arrow.TimeUnit) *array.Time64 {
+	values := make([]arrow.Time64, len(ts))
+	valid := make([]bool, len(ts))
+	for i, t := range ts {
+		if valid[i] = t.Valid; t.Valid {
+			values[i] = ToTime64(t, unit)
+		}
+	}
+	b := array.NewTime64Builder(mem, &arrow.Time64Type{Unit: unit})
+	defer b.Release()
+	b.AppendValues(values, valid)
+	return b.NewTime64Array()
+}
+
+// Times64 returns the values of a as Times.
+func Times64(a *array.Time64) []dt.Time {
+	unit := a.DataType().(*arrow.Time64Type).Unit
+	ts := make([]dt.Time, a.Len())
+	for i, v := range a.Time64Values() {
+		if a.IsValid(i) {
+			ts[i] = FromTime64(v, unit)
+		}
+	}
+	return ts
+}
+
+// NewTimestampArray returns a Timestamp array of the given unit and without a
+// time zone holding ds. DateTimes with either part not Valid are stored as nulls.
+// It fails if ToTimestamp fails for any of them.
+func NewTimestampArray(mem memory.Allocator, ds []dt.DateTime, unit arrow.TimeUnit) (*array.Timestamp, error) {
+	values := make([]arrow.Timestamp, len(ds))
+	valid := make([]bool, len(ds))
+	for i, d := range ds {
+		if valid[i] = d.Date.Valid && d.Time.Valid; valid[i] {
+			var err error
+			if values[i], err = ToTimestamp(d, unit); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: unit})
+	defer b.Release()
+	b.AppendValues(values, valid)
+	return b.NewTimestampArray(), nil
+}
+
+// DateTimes returns the values of a as DateTimes, ignoring any time zone of a.
+func DateTimes(a *array.Timestamp) []dt.DateTime {
+	unit := a.DataType().(*arrow.TimestampType).Unit
+	ds := make([]dt.DateTime, a.Len())
+	for i, v := range a.TimestampValues() {
+		if a.IsValid(i) {
+			ds[i] = FromTimestamp(v, unit)
+		}
+	}
+	return ds
+}
+
+func sinceMidnight(t dt.Time) time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute
+}
+
+// timeOfUnits returns the Time of day v units after midnight, or a Time that
+// is not Valid if v is outside of the day.
+func timeOfUnits(v int64, unit arrow.TimeUnit) dt.Time {
+	if v < 0 || v >= int64(24*time.Hour/unit.Multiplier()) {
+		return dt.Time{}
+	}
+	return timeOf(time.Duration(v) * unit.Multiplier())
+}
+
+func timeOf(d time.Duration) dt.Time {
+	return dt.Time{Hour: int(d / time.Hour), Minute: int(d % time.Hour / time.Minute), Valid: true}
+}
@@ -0,0 +1,214 @@
+package dtarrow
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/ribice/dt"
+)
+
+func TestDate32(t *testing.T) {
+	for _, tt := range []struct {
+		d dt.Date
+		v arrow.Date32
+	}{
+		{dt.Date{Year: 1970, Month: 1, Day: 1, Valid: true}, 0},
+		{dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true}, 19782},
+		{dt.Date{Year: 1969, Month: 12, Day: 31, Valid: true}, -1},
+	} {
+		if got, err := ToDate32(tt.d); err != nil || got != tt.v {
+			t.Errorf("ToDate32(%v): got %d (error %v), want %d", tt.d, got, err, tt.v)
+		}
+		if got := FromDate32(tt.v); got != tt.d {
+			t.Errorf("FromDate32(%d): got %v, want %v", tt.v, got, tt.d)
+		}
+	}
+	for _, tt := range []struct {
+		v    arrow.Date32
+		step int
+	}{
+		{math.MaxInt32, 1},
+		{math.MinInt32, -1},
+	} {
+		d := FromDate32(tt.v)
+		if got, err := ToDate32(d); err != nil || got != tt.v {
+			t.Errorf("ToDate32(%v): got %d (error %v), want %d", d, got, err, tt.v)
+		}
+		if _, err := ToDate32(d.AddDays(tt.step)); err == nil {
+			t.Errorf("ToDate32(%v): expected error", d.AddDays(tt.step))
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	tm := dt.Time{Hour: 18, Minute: 30, Valid: true}
+	for _, tt := range []struct {
+		unit arrow.TimeUnit
+		v    int64
+	}{
+		{arrow.Second, 66600},
+		{arrow.Millisecond, 66600000},
+		{arrow.Microsecond, 66600000000},
+		{arrow.Nanosecond, 66600000000000},
+	} {
+		if tt.unit <= arrow.Millisecond {
+			if got := ToTime32(tm, tt.unit); int64(got) != tt.v {
+				t.Errorf("ToTime32(%v, %v): got %d, want %d", tm, tt.unit, got, tt.v)
+			}
+			if got := FromTime32(arrow.Time32(tt.v+1), tt.unit); got != tm {
+				t.Errorf("FromTime32(%d, %v): got %v, want %v", tt.v+1, tt.unit, got, tm)
+			}
+			continue
+		}
+		if got := ToTime64(tm, tt.unit); int64(got) != tt.v {
+			t.Errorf("ToTime64(%v, %v): got %d, want %d", tm, tt.unit, got, tt.v)
+		}
+		if got := FromTime64(arrow.Time64(tt.v+1), tt.unit); got != tm {
+			t.Errorf("FromTime64(%d, %v): got %v, want %v", tt.v+1, tt.unit, got, tm)
+		}
+	}
+}
+
+func TestTimeOutOfRange(t *testing.T) {
+	for _, tt := range []struct {
+		unit arrow.TimeUnit
+		v    int64
+	}{
+		{arrow.Second, -1},
+		{arrow.Second, 86400},
+		{arrow.Millisecond, 86400000},
+		{arrow.Microsecond, -1},
+		{arrow.Microsecond, 86400000000},
+		{arrow.Nanosecond, 86400000000000},
+		{arrow.Nanosecond, 1 << 62},
+	} {
+		var got dt.Time
+		if tt.unit <= arrow.Millisecond {
+			got = FromTime32(arrow.Time32(tt.v), tt.unit)
+		} else {
+			got = FromTime64(arrow.Time64(tt.v), tt.unit)
+		}
+		if got.Valid {
+			t.Errorf("FromTime(%d, %v): got %v, want a Time that is not Valid", tt.v, tt.unit, got)
+		}
+	}
+	if got := FromTime64(86399999999999, arrow.Nanosecond); got != (dt.Time{Hour: 23, Minute: 59, Valid: true}) {
+		t.Errorf("FromTime64 of the last nanosecond: got %v", got)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	for _, tt := range []struct {
+		d    dt.DateTime
+		unit arrow.TimeUnit
+		v    arrow.Timestamp
+	}{
+		{
+			d:    dt.DateTime{Date: dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true}, Time: dt.Time{Hour: 18, Minute: 30, Valid: true}},
+			unit: arrow.Millisecond,
+			v:    19782*86400000 + 66600000,
+		},
+		{
+			d:    dt.DateTime{Date: dt.Date{Year: 1969, Month: 12, Day: 31, Valid: true}, Time: dt.Time{Hour: 23, Minute: 0, Valid: true}},
+			unit: arrow.Second,
+			v:    -3600,
+		},
+		{
+			d:    dt.DateTime{Date: dt.Date{Year: 1970, Month: 1, Day: 1, Valid: true}, Time: dt.Time{Hour: 0, Minute: 1, Valid: true}},
+			unit: arrow.Nanosecond,
+			v:    60000000000,
+		},
+	} {
+		if got, err := ToTimestamp(tt.d, tt.unit); err != nil || got != tt.v {
+			t.Errorf("ToTimestamp(%v, %v): got %d (error %v), want %d", tt.d, tt.unit, got, err, tt.v)
+		}
+		if got := FromTimestamp(tt.v, tt.unit); got != tt.d {
+			t.Errorf("FromTimestamp(%d, %v): got %v, want %v", tt.v, tt.unit, got, tt.d)
+		}
+	}
+}
+
+func TestTimestampOutOfRange(t *testing.T) {
+	at := func(y, m, d, h, min int) dt.DateTime {
+		return dt.DateTime{Date: dt.Date{Year: y, Month: time.Month(m), Day: d, Valid: true}, Time: dt.Time{Hour: h, Minute: min, Valid: true}}
+	}
+	for _, tt := range []struct {
+		d    dt.DateTime
+		unit arrow.TimeUnit
+		ok   bool
+	}{
+		// Nanoseconds since the epoch fit in int64 from 1677-09-21T00:12:43.145224192
+		// to 2262-04-11T23:47:16.854775807.
+		{at(2262, 4, 11, 23, 47), arrow.Nanosecond, true},
+		{at(2262, 4, 11, 23, 48), arrow.Nanosecond, false},
+		{at(2263, 1, 1, 0, 0), arrow.Nanosecond, false},
+		{at(1677, 9, 21, 0, 13), arrow.Nanosecond, true},
+		{at(1677, 9, 21, 0, 12), arrow.Nanosecond, false},
+		{at(9999, 12, 31, 23, 59), arrow.Microsecond, true},
+		{at(-9999, 1, 1, 0, 0), arrow.Microsecond, true},
+	} {
+		v, err := ToTimestamp(tt.d, tt.unit)
+		if (err == nil) != tt.ok {
+			t.Errorf("ToTimestamp(%v, %v): got %d (error %v)", tt.d, tt.unit, v, err)
+		}
+		if err == nil && FromTimestamp(v, tt.unit) != tt.d {
+			t.Errorf("ToTimestamp(%v, %v) = %d does not convert back", tt.d, tt.unit, v)
+		}
+	}
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	if _, err := NewTimestampArray(mem, []dt.DateTime{at(2300, 1, 1, 0, 0)}, arrow.Nanosecond); err == nil {
+		t.Error("NewTimestampArray: expected error")
+	}
+	if _, err := NewDate32Array(mem, []dt.Date{{Year: 6000000, Month: 1, Day: 1, Valid: true}}); err == nil {
+		t.Error("NewDate32Array: expected error")
+	}
+}
+
+func TestArrays(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ds := []dt.Date{{Year: 2024, Month: 1, Day: 1, Valid: true}, {}, {Year: 1960, Month: 6, Day: 15, Valid: true}}
+	da, err := NewDate32Array(mem, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer da.Release()
+	if da.NullN() != 1 {
+		t.Errorf("expected 1 null, got %d", da.NullN())
+	}
+	if got := Dates(da); !reflect.DeepEqual(got, ds) {
+		t.Errorf("expected %v, got %v", ds, got)
+	}
+
+	ts := []dt.Time{{Hour: 9, Minute: 15, Valid: true}, {}, {Hour: 23, Minute: 59, Valid: true}}
+	t32 := NewTime32Array(mem, ts, arrow.Millisecond)
+	defer t32.Release()
+	if got := Times32(t32); !reflect.DeepEqual(got, ts) {
+		t.Errorf("expected %v, got %v", ts, got)
+	}
+	t64 := NewTime64Array(mem, ts, arrow.Microsecond)
+	defer t64.Release()
+	if got := Times64(t64); !reflect.DeepEqual(got, ts) {
+		t.Errorf("expected %v, got %v", ts, got)
+	}
+
+	dts := []dt.DateTime{{Date: ds[0], Time: ts[0]}, {Date: ds[0]}, {Date: ds[2], Time: ts[2]}}
+	tsa, err := NewTimestampArray(mem, dts, arrow.Microsecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tsa.Release()
+	if typ := tsa.DataType().(*arrow.TimestampType); typ.TimeZone != "" {
+		t.Errorf("expected timestamp without time zone, got %q", typ.TimeZone)
+	}
+	want := []dt.DateTime{dts[0], {}, dts[2]}
+	if got := DateTimes(tsa); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,221 @@
+// Package dtbson provides BSON encoding of dt types for the MongoDB Go driver.
+//
+// Without it, dt values are stored as nested documents with a Valid field.
+// Registering the codecs from this package stores them as scalar BSON values
+// instead, and stores values that are not Valid as BSON null:
+//
+//	reg := dtbson.NewRegistry(dtbson.Options{})
+//	client, err := mongo.Connect(options.Client().ApplyURI(uri).SetRegistry(reg))
+//
+// Decoding accepts every representation regardless of Options, so the
+// encoding of a field can be changed without migrating existing documents.
+package dtbson
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ribice/dt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// DateEncoding selects how dt.Date values are stored.
+type DateEncoding int
+
+const (
+	// DateAsString stores dates as YYYY-MM-DD strings.
+	DateAsString DateEncoding = iota
+	// DateAsDateTime stores dates as BSON datetimes at midnight UTC.
+	DateAsDateTime
+)
+
+// TimeEncoding selects how dt.Time values are stored.
+type TimeEncoding int
+
+const (
+	// TimeAsMinutes stores times as 32-bit integers counting minutes since midnight.
+	TimeAsMinutes TimeEncoding = iota
+	// TimeAsString stores times as HH:MM strings.
+	TimeAsString
+)
+
+// DateTimeEncoding selects how dt.DateTime values are stored.
+type DateTimeEncoding int
+
+const (
+	// DateTimeAsDateTime stores datetimes as BSON datetimes, interpreting them as UTC.
+	DateTimeAsDateTime DateTimeEncoding = iota
+	// DateTimeAsString stores datetimes as YYYY-MM-DDTHH:MM strings.
+	DateTimeAsString
+)
+
+// Options configure the encoding of dt types. The zero value stores dates as
+// strings, times as minutes since midnight and datetimes as BSON datetimes.
+type Options struct {
+	Date     DateEncoding
+	Time     TimeEncoding
+	DateTime DateTimeEncoding
+}
+
+var (
+	tDate     = reflect.TypeOf(dt.Date{})
+	tTime     = reflect.TypeOf(dt.Time{})
+	tDateTime = reflect.TypeOf(dt.DateTime{})
+)
+
+// NewRegistry returns the default BSON registry with the dt codecs registered.
+func NewRegistry(opts Options) *bson.Registry {
+	r := bson.NewRegistry()
+	Register(r, opts)
+	return r
+}
+
+// Register registers encoders and decoders for dt.Date, dt.Time and dt.DateTime on r.
+func Register(r *bson.Registry, opts Options) {
+	c := codec{opts: opts}
+	for _, t := range []reflect.Type{tDate, tTime, tDateTime} {
+		r.RegisterTypeEncoder(t, c)
+		r.RegisterTypeDecoder(t, c)
+	}
+}
+
+type codec struct {
+	opts Options
+}
+
+func (c codec) EncodeValue(_ bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	switch v := val.Interface().(type) {
+	case dt.Date:
+		if !v.Valid {
+			return vw.WriteNull()
+		}
+		if _, err := dt.NewDate(v.Year, v.Month, v.Day); err != nil {
+			return err
+		}
+		if c.opts.Date == DateAsDateTime {
+			return vw.WriteDateTime(v.ToTime().UnixMilli())
+		}
+		return vw.WriteString(v.String())
+	case dt.Time:
+		if !v.Valid {
+			return vw.WriteNull()
+		}
+		if _, err := dt.NewTime(v.Hour, v.Minute); err != nil {
+			return err
+		}
+		if c.opts.Time == TimeAsString {
+			return vw.WriteString(v.String())
+		}
+		return vw.WriteInt32(int32(v.Hour*60 + v.Minute))
+	case dt.DateTime:
+		if !v.Date.Valid || !v.Time.Valid {
+			return vw.WriteNull()
+		}
+		if _, err := dt.NewDateTime(v.Date.Year, v.Date.Month, v.Date.Day, v.Time.Hour, v.Time.Minute); err != nil {
+			return err
+		}
+		if c.opts.DateTime == DateTimeAsString {
+			return vw.WriteString(v.String())
+		}
+		return vw.WriteDateTime(v.In(time.UTC).UnixMilli())
+	}
+	return bson.ValueEncoderError{Name: "dtbson.codec", Types: []reflect.Type{tDate, tTime, tDateTime}, Received: val}
+}
+
+func (c codec) DecodeValue(_ bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	if !val.CanSet() {
+		return bson.ValueDecoderError{Name: "dtbson.codec", Types: []reflect.Type{tDate, tTime, tDateTime}, Received: val}
+	}
+	if vr.Type() == bson.TypeNull {
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	}
+
+	var v interface{}
+	var err error
+	switch val.Type() {
+	case tDate:
+		v, err = decodeDate(vr)
+	case tTime:
+		v, err = decodeTime(vr)
+	case tDateTime:
+		v, err = decodeDateTime(vr)
+	default:
+		return bson.ValueDecoderError{Name: "dtbson.codec", Types: []reflect.Type{tDate, tTime, tDateTime}, Received: val}
+	}
+	if err != nil {
+		return err
+	}
+	val.Set(reflect.ValueOf(v))
+	return nil
+}
+
+func decodeDate(vr bson.ValueReader) (dt.Date, error) {
+	switch vr.Type() {
+	case bson.TypeString:
+		s, err := vr.ReadString()
+		if err != nil {
+			return dt.Date{}, err
+		}
+		return dt.ParseDate(s)
+	case bson.TypeDateTime:
+		ms, err := vr.ReadDateTime()
+		if err != nil {
+			return dt.Date{}, err
+		}
+		return dt.DateOf(time.UnixMilli(ms).UTC()), nil
+	}
+	return dt.Date{}, fmt.Errorf("dtbson: cannot decode %v into dt.Date", vr.Type())
+}
+
+func decodeTime(vr bson.ValueReader) (dt.Time, error) {
+	var mins int64
+	switch vr.Type() {
+	case bson.TypeString:
+		s, err := vr.ReadString()
+		if err != nil {
+			return dt.Time{}, err
+		}
+		return dt.ParseTime(s)
+	case bson.TypeInt32:
+		i, err := vr.ReadInt32()
+		if err != nil {
+			return dt.Time{}, err
+		}
+		mins = int64(i)
+	case bson.TypeInt64:
+		i, err := vr.ReadInt64()
+		if err != nil {
+			return dt.Time{}, err
+		}
+		mins = i
+	default:
+		return dt.Time{}, fmt.Errorf("dtbson: cannot decode %v into dt.Time", vr.Type())
+	}
+	if mins < 0 || mins >= 24*60 {
+		return dt.Time{}, &dt.RangeError{Field: "minute", Value: int(mins)}
+	}
+	return dt.Time{Hour: int(mins / 60), Minute: int(mins % 60), Valid: true}, nil
+}
+
+func decodeDateTime(vr bson.ValueReader) (dt.DateTime, error) {
+	switch vr.Type() {
+	case bson.TypeString:
+		s, err := vr.ReadString()
+		if err != nil {
+			return dt.DateTime{}, err
+		}
+		return dt.ParseDateTime(s)
+	case bson.TypeDateTime:
+		ms, err := vr.ReadDateTime()
+		if err != nil {
+			return dt.DateTime{}, err
+		}
+		return dt.DateTimeOf(time.UnixMilli(ms).UTC()), nil
+	}
+	return dt.DateTime{}, fmt.Errorf("dtbson: cannot decode %v into dt.DateTime", vr.Type())
+}
@@ -0,0 +1,147 @@
+package dtbson
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type doc struct {
+	D  dt.Date     `bson:"d"`
+	T  dt.Time     `bson:"t"`
+	DT dt.DateTime `bson:"dt"`
+}
+
+func marshal(t *testing.T, reg *bson.Registry, v interface{}) bson.Raw {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(reg)
+	if err := enc.Encode(v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func unmarshal(t *testing.T, reg *bson.Registry, raw []byte, v interface{}) error {
+	t.Helper()
+	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(raw)))
+	dec.SetRegistry(reg)
+	return dec.Decode(v)
+}
+
+func TestEncode(t *testing.T) {
+	d := doc{
+		D:  dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true},
+		T:  dt.Time{Hour: 18, Minute: 30, Valid: true},
+		DT: dt.DateTime{Date: dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true}, Time: dt.Time{Hour: 18, Minute: 30, Valid: true}},
+	}
+	midnight := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	evening := time.Date(2024, 2, 29, 18, 30, 0, 0, time.UTC)
+	cases := []struct {
+		name string
+		opts Options
+		req  doc
+		want bson.D
+	}{
+		{
+			name: "Default encoding",
+			req:  d,
+			want: bson.D{{Key: "d", Value: "2024-02-29"}, {Key: "t", Value: int32(1110)}, {Key: "dt", Value: bson.NewDateTimeFromTime(evening)}},
+		},
+		{
+			name: "Alternative encoding",
+			opts: Options{Date: DateAsDateTime, Time: TimeAsString, DateTime: DateTimeAsString},
+			req:  d,
+			want: bson.D{{Key: "d", Value: bson.NewDateTimeFromTime(midnight)}, {Key: "t", Value: "18:30"}, {Key: "dt", Value: "2024-02-29T18:30"}},
+		},
+		{
+			name: "Invalid values",
+			want: bson.D{{Key: "d", Value: nil}, {Key: "t", Value: nil}, {Key: "dt", Value: nil}},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := NewRegistry(tt.opts)
+			got := marshal(t, reg, tt.req)
+			if want := marshal(t, bson.NewRegistry(), tt.want); !bytes.Equal(got, want) {
+				t.Errorf("expected %v, got %v", bson.Raw(want), got)
+			}
+
+			var back doc
+			if err := unmarshal(t, reg, got, &back); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if back != tt.req {
+				t.Errorf("expected %v, got %v", tt.req, back)
+			}
+		})
+	}
+}
+
+func TestEncodeOutOfRange(t *testing.T) {
+	reg := NewRegistry(Options{})
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(reg)
+	var rerr *dt.RangeError
+	if err := enc.Encode(doc{T: dt.Time{Hour: 25, Valid: true}}); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError, got %v", err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	want := doc{
+		D:  dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true},
+		T:  dt.Time{Hour: 18, Minute: 30, Valid: true},
+		DT: dt.DateTime{Date: dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true}, Time: dt.Time{Hour: 18, Minute: 30, Valid: true}},
+	}
+	cases := []struct {
+		name    string
+		req     bson.D
+		want    doc
+		wantErr bool
+	}{
+		{
+			name: "Int64 minutes",
+			req:  bson.D{{Key: "d", Value: "2024-02-29"}, {Key: "t", Value: int64(1110)}, {Key: "dt", Value: "2024-02-29 18:30"}},
+			want: want,
+		},
+		{
+			name: "Missing and null fields",
+			req:  bson.D{{Key: "d", Value: nil}},
+		},
+		{
+			name:    "Minutes out of range",
+			req:     bson.D{{Key: "t", Value: int32(1440)}},
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported type",
+			req:     bson.D{{Key: "d", Value: true}},
+			wantErr: true,
+		},
+		{
+			name:    "Unparsable string",
+			req:     bson.D{{Key: "dt", Value: "2024-02-30T10:00"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := NewRegistry(Options{})
+			var got doc
+			err := unmarshal(t, reg, marshal(t, bson.NewRegistry(), tt.req), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/ribice/dt/dtbson
+
+go 1.23.3
+
+require (
+	github.com/ribice/dt v0.1.0
+	go.mongodb.org/mongo-driver/v2 v2.3.0
+)
@@ -0,0 +1,206 @@
+// Package dtpb converts between dt types and their Protocol Buffers
+// counterparts: google.type.Date, google.type.TimeOfDay, google.type.DateTime
+// and google.protobuf.Timestamp.
+//
+// Values that are not Valid convert to nil messages, and nil messages convert
+// to values that are not Valid. Seconds and nanoseconds of incoming messages
+// are truncated, as dt types have minute precision.
+package dtpb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ribice/dt"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrPartialDate is returned when a message omits the year, month or day,
+	// which a dt.Date cannot represent.
+	ErrPartialDate = errors.New("dtpb: partial date cannot be represented")
+
+	// ErrNoOffset is returned when converting a google.type.DateTime without
+	// a UTC offset or time zone into a dt.OffsetDateTime.
+	ErrNoOffset = errors.New("dtpb: datetime has no UTC offset or time zone")
+)
+
+// FromDate converts d into a google.type.Date.
+// The year must be within [1-9999], as year 0 denotes a partial date in google.type.Date.
+func FromDate(d dt.Date) (*date.Date, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	if err := checkDate(d); err != nil {
+		return nil, err
+	}
+	return &date.Date{Year: int32(d.Year), Month: int32(d.Month), Day: int32(d.Day)}, nil
+}
+
+// ToDate converts a google.type.Date into a dt.Date.
+// Partial dates, with a zero year, month or day, result in ErrPartialDate.
+func ToDate(pb *date.Date) (dt.Date, error) {
+	if pb == nil {
+		return dt.Date{}, nil
+	}
+	return newDate(pb.Year, pb.Month, pb.Day)
+}
+
+// FromTime converts t into a google.type.TimeOfDay.
+func FromTime(t dt.Time) (*timeofday.TimeOfDay, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	if _, err := dt.NewTime(t.Hour, t.Minute); err != nil {
+		return nil, err
+	}
+	return &timeofday.TimeOfDay{Hours: int32(t.Hour), Minutes: int32(t.Minute)}, nil
+}
+
+// ToTime converts a google.type.TimeOfDay into a dt.Time.
+// The end-of-day value 24:00 is not representable and results in a *dt.RangeError.
+func ToTime(pb *timeofday.TimeOfDay) (dt.Time, error) {
+	if pb == nil {
+		return dt.Time{}, nil
+	}
+	return dt.NewTime(int(pb.Hours), int(pb.Minutes))
+}
+
+// FromDateTime converts d into a google.type.DateTime without a time offset,
+// representing civil time.
+func FromDateTime(d dt.DateTime) (*datetime.DateTime, error) {
+	if !d.Date.Valid || !d.Time.Valid {
+		return nil, nil
+	}
+	if err := checkDate(d.Date); err != nil {
+		return nil, err
+	}
+	if _, err := dt.NewTime(d.Time.Hour, d.Time.Minute); err != nil {
+		return nil, err
+	}
+	return &datetime.DateTime{
+		Year:    int32(d.Date.Year),
+		Month:   int32(d.Date.Month),
+		Day:     int32(d.Date.Day),
+		Hours:   int32(d.Time.Hour),
+		Minutes: int32(d.Time.Minute),
+	}, nil
+}
+
+// ToDateTime converts a google.type.DateTime into a dt.DateTime.
+// The civil date and time are kept as is; any UTC offset or time zone is ignored.
+// Use ToOffsetDateTime to take it into account.
+func ToDateTime(pb *datetime.DateTime) (dt.DateTime, error) {
+	if pb == nil {
+		return dt.DateTime{}, nil
+	}
+	d, err := newDate(pb.Year, pb.Month, pb.Day)
+	if err != nil {
+		return dt.DateTime{}, err
+	}
+	t, err := dt.NewTime(int(pb.Hours), int(pb.Minutes))
+	if err != nil {
+		return dt.DateTime{}, err
+	}
+	return dt.DateTime{Date: d, Time: t}, nil
+}
+
+// FromOffsetDateTime converts d into a google.type.DateTime with its UTC offset set.
+func FromOffsetDateTime(d dt.OffsetDateTime) (*datetime.DateTime, error) {
+	pb, err := FromDateTime(d.DateTime)
+	if pb == nil || err != nil {
+		return nil, err
+	}
+	pb.TimeOffset = &datetime.DateTime_UtcOffset{
+		UtcOffset: durationpb.New(time.Duration(d.Offset) * time.Second),
+	}
+	return pb, nil
+}
+
+// ToOffsetDateTime converts a google.type.DateTime into a dt.OffsetDateTime.
+// Messages with a time zone are resolved to the offset in effect in that zone,
+// and messages with neither an offset nor a time zone result in ErrNoOffset.
+func ToOffsetDateTime(pb *datetime.DateTime) (dt.OffsetDateTime, error) {
+	if pb == nil {
+		return dt.OffsetDateTime{}, nil
+	}
+	d, err := ToDateTime(pb)
+	if err != nil {
+		return dt.OffsetDateTime{}, err
+	}
+	switch o := pb.TimeOffset.(type) {
+	case *datetime.DateTime_UtcOffset:
+		return dt.OffsetDateTime{DateTime: d, Offset: int(o.UtcOffset.AsDuration() / time.Second)}, nil
+	case *datetime.DateTime_TimeZone:
+		loc, err := time.LoadLocation(o.TimeZone.GetId())
+		if err != nil {
+			return dt.OffsetDateTime{}, fmt.Errorf("dtpb: %w", err)
+		}
+		return dt.OffsetDateTimeOf(d.In(loc)), nil
+	}
+	return dt.OffsetDateTime{}, ErrNoOffset
+}
+
+// TimestampFromDateTime converts d, interpreted in loc, into a google.protobuf.Timestamp.
+//
+// TimestampFromDateTime panics if loc is nil.
+func TimestampFromDateTime(d dt.DateTime, loc *time.Location) *timestamppb.Timestamp {
+	if !d.Date.Valid || !d.Time.Valid {
+		return nil
+	}
+	return timestamppb.New(d.In(loc))
+}
+
+// DateTimeFromTimestamp converts a google.protobuf.Timestamp into the dt.DateTime it
+// represents in loc.
+//
+// DateTimeFromTimestamp panics if loc is nil.
+func DateTimeFromTimestamp(pb *timestamppb.Timestamp, loc *time.Location) (dt.DateTime, error) {
+	if pb == nil {
+		return dt.DateTime{}, nil
+	}
+	if err := pb.CheckValid(); err != nil {
+		return dt.DateTime{}, err
+	}
+	return dt.DateTimeOf(pb.AsTime().In(loc)), nil
+}
+
+// TimestampFromOffsetDateTime converts the instant of d into a google.protobuf.Timestamp.
+func TimestampFromOffsetDateTime(d dt.OffsetDateTime) *timestamppb.Timestamp {
+	if !d.DateTime.Date.Valid || !d.DateTime.Time.Valid {
+		return nil
+	}
+	return timestamppb.New(d.ToTime())
+}
+
+// OffsetDateTimeFromTimestamp converts a google.protobuf.Timestamp into a
+// dt.OffsetDateTime in UTC.
+func OffsetDateTimeFromTimestamp(pb *timestamppb.Timestamp) (dt.OffsetDateTime, error) {
+	if pb == nil {
+		return dt.OffsetDateTime{}, nil
+	}
+	if err := pb.CheckValid(); err != nil {
+		return dt.OffsetDateTime{}, err
+	}
+	return dt.OffsetDateTimeOf(pb.AsTime()), nil
+}
+
+func newDate(year, month, day int32) (dt.Date, error) {
+	if year == 0 || month == 0 || day == 0 {
+		return dt.Date{}, ErrPartialDate
+	}
+	return dt.NewDate(int(year), time.Month(month), int(day))
+}
+
+func checkDate(d dt.Date) error {
+	if d.Year < 1 || d.Year > 9999 {
+		return &dt.RangeError{Field: "year", Value: d.Year}
+	}
+	_, err := dt.NewDate(d.Year, d.Month, d.Day)
+	return err
+}
@@ -0,0 +1,196 @@
+package dtpb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDate(t *testing.T) {
+	cases := []struct {
+		name    string
+		d       dt.Date
+		pb      *date.Date
+		wantErr error
+	}{
+		{
+			name: "Valid date",
+			d:    dt.Date{Year: 2024, Month: 2, Day: 29, Valid: true},
+			pb:   &date.Date{Year: 2024, Month: 2, Day: 29},
+		},
+		{
+			name: "Invalid date",
+		},
+		{
+			name:    "Partial date without year",
+			pb:      &date.Date{Month: 12, Day: 25},
+			wantErr: ErrPartialDate,
+		},
+		{
+			name:    "Partial date without day",
+			pb:      &date.Date{Year: 2024, Month: 12},
+			wantErr: ErrPartialDate,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToDate(tt.pb)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.d {
+				t.Errorf("expected %v, got %v", tt.d, got)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			pb, err := FromDate(tt.d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !proto.Equal(pb, tt.pb) {
+				t.Errorf("expected %v, got %v", tt.pb, pb)
+			}
+		})
+	}
+}
+
+func TestFromDateOutOfRange(t *testing.T) {
+	var rerr *dt.RangeError
+	if _, err := FromDate(dt.Date{Year: 0, Month: 1, Day: 1, Valid: true}); !errors.As(err, &rerr) || rerr.Field != "year" {
+		t.Errorf("expected year RangeError, got %v", err)
+	}
+	if _, err := FromDate(dt.Date{Year: 2023, Month: 2, Day: 29, Valid: true}); !errors.As(err, &rerr) || rerr.Field != "day" {
+		t.Errorf("expected day RangeError, got %v", err)
+	}
+}
+
+func TestTime(t *testing.T) {
+	tm := dt.Time{Hour: 18, Minute: 30, Valid: true}
+	pb, err := FromTime(tm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (&timeofday.TimeOfDay{Hours: 18, Minutes: 30}); !proto.Equal(pb, want) {
+		t.Errorf("expected %v, got %v", want, pb)
+	}
+
+	got, err := ToTime(&timeofday.TimeOfDay{Hours: 18, Minutes: 30, Seconds: 59, Nanos: 1})
+	if err != nil || got != tm {
+		t.Errorf("expected %v, got %v (error %v)", tm, got, err)
+	}
+
+	if pb, err := FromTime(dt.Time{}); pb != nil || err != nil {
+		t.Errorf("expected nil, got %v (error %v)", pb, err)
+	}
+	if got, err := ToTime(nil); got.Valid || err != nil {
+		t.Errorf("expected invalid time, got %v (error %v)", got, err)
+	}
+
+	var rerr *dt.RangeError
+	if _, err := ToTime(&timeofday.TimeOfDay{Hours: 24}); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError, got %v", err)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	d := dt.DateTime{
+		Date: dt.Date{Year: 2024, Month: 1, Day: 1, Valid: true},
+		Time: dt.Time{Hour: 10, Minute: 30, Valid: true},
+	}
+	pb, err := FromDateTime(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := &datetime.DateTime{Year: 2024, Month: 1, Day: 1, Hours: 10, Minutes: 30}
+	if !proto.Equal(pb, want) {
+		t.Errorf("expected %v, got %v", want, pb)
+	}
+
+	got, err := ToDateTime(pb)
+	if err != nil || got != d {
+		t.Errorf("expected %v, got %v (error %v)", d, got, err)
+	}
+
+	if pb, err := FromDateTime(dt.DateTime{Date: d.Date}); pb != nil || err != nil {
+		t.Errorf("expected nil, got %v (error %v)", pb, err)
+	}
+	if _, err := ToDateTime(&datetime.DateTime{Month: 1, Day: 1, Hours: 10}); !errors.Is(err, ErrPartialDate) {
+		t.Errorf("expected ErrPartialDate, got %v", err)
+	}
+}
+
+func TestOffsetDateTime(t *testing.T) {
+	d := dt.OffsetDateTime{
+		DateTime: dt.DateTime{
+			Date: dt.Date{Year: 2024, Month: 7, Day: 1, Valid: true},
+			Time: dt.Time{Hour: 10, Minute: 30, Valid: true},
+		},
+		Offset: 7200,
+	}
+	pb, err := FromOffsetDateTime(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := &datetime.DateTime{
+		Year: 2024, Month: 7, Day: 1, Hours: 10, Minutes: 30,
+		TimeOffset: &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(2 * time.Hour)},
+	}
+	if !proto.Equal(pb, want) {
+		t.Errorf("expected %v, got %v", want, pb)
+	}
+
+	got, err := ToOffsetDateTime(pb)
+	if err != nil || got != d {
+		t.Errorf("expected %v, got %v (error %v)", d, got, err)
+	}
+
+	pb.TimeOffset = &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "Europe/Berlin"}}
+	got, err = ToOffsetDateTime(pb)
+	if err != nil || got != d {
+		t.Errorf("expected %v, got %v (error %v)", d, got, err)
+	}
+
+	pb.TimeOffset = nil
+	if _, err := ToOffsetDateTime(pb); !errors.Is(err, ErrNoOffset) {
+		t.Errorf("expected ErrNoOffset, got %v", err)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	loc := time.FixedZone("", 7200)
+	d := dt.DateTime{
+		Date: dt.Date{Year: 2024, Month: 1, Day: 1, Valid: true},
+		Time: dt.Time{Hour: 1, Minute: 30, Valid: true},
+	}
+	ts := TimestampFromDateTime(d, loc)
+	if want := timestamppb.New(time.Date(2023, 12, 31, 23, 30, 0, 0, time.UTC)); !proto.Equal(ts, want) {
+		t.Errorf("expected %v, got %v", want, ts)
+	}
+	got, err := DateTimeFromTimestamp(ts, loc)
+	if err != nil || got != d {
+		t.Errorf("expected %v, got %v (error %v)", d, got, err)
+	}
+	if ts := TimestampFromDateTime(dt.DateTime{}, loc); ts != nil {
+		t.Errorf("expected nil, got %v", ts)
+	}
+
+	odt := dt.OffsetDateTime{DateTime: d, Offset: 7200}
+	ts = TimestampFromOffsetDateTime(odt)
+	godt, err := OffsetDateTimeFromTimestamp(ts)
+	if err != nil || !godt.Equal(odt) || godt.Offset != 0 {
+		t.Errorf("expected %v in UTC, got %v (error %v)", odt, godt, err)
+	}
+
+	if _, err := OffsetDateTimeFromTimestamp(&timestamppb.Timestamp{Nanos: -1}); err == nil {
+		t.Error("expected error for invalid timestamp")
+	}
+}
@@ -0,0 +1,9 @@
+module github.com/ribice/dt/dtpb
+
+go 1.23.3
+
+require (
+	github.com/ribice/dt v0.1.0
+	google.golang.org/genproto v0.0.0-20241118233622-e639e219e697
+	google.golang.org/protobuf v1.36.1
+)
@@ -0,0 +1,66 @@
+package dt
+
+import "time"
+
+// Easter returns the date of Western Easter Sunday in year, following the
+// Gregorian computus with the anonymous Gregorian algorithm.
+func Easter(year int) Date {
+	a := floorMod(year, 19)
+	b, c := floorDiv(year, 100), floorMod(year, 100)
+	d, e := floorDiv(b, 4), floorMod(b, 4)
+	g := floorDiv(8*b+13, 25)
+	h := floorMod(19*a+b-d-g+15, 30)
+	i, k := c/4, c%4
+	l := floorMod(32+2*e+2*i-h-k, 7)
+	m := (a + 11*h + 19*l) / 433
+	month := (h + l - 7*m + 90) / 25
+	day := (h + l - 7*m + 33*month + 19) % 32
+	return Date{year, time.Month(month), day, true}
+}
+
+// OrthodoxEaster returns the Gregorian date of Orthodox Easter Sunday in
+// year, following the Julian computus with the Meeus algorithm. The Julian
+// calendar drifts from the Gregorian one by about three days every four
+// centuries, so far from the present the date may fall in another year.
+func OrthodoxEaster(year int) Date {
+	a, b, c := floorMod(year, 4), floorMod(year, 7), floorMod(year, 19)
+	d := (19*c + 15) % 30
+	e := floorMod(2*a+4*b-d+34, 7)
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+	return DateFromJulianDayNumber(julianCalendarDay(year, month, day))
+}
+
+// julianCalendarDay returns the Julian Day Number of a date of the Julian calendar.
+func julianCalendarDay(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + floorDiv(y, 4) - 32083
+}
+
+// AshWednesday returns the date of Ash Wednesday, 46 days before Western Easter, in year.
+func AshWednesday(year int) Date {
+	return Easter(year).AddDays(-46)
+}
+
+// GoodFriday returns the date of Good Friday, 2 days before Western Easter, in year.
+func GoodFriday(year int) Date {
+	return Easter(year).AddDays(-2)
+}
+
+// Ascension returns the date of Ascension Day, 39 days after Western Easter, in year.
+func Ascension(year int) Date {
+	return Easter(year).AddDays(39)
+}
+
+// Pentecost returns the date of Pentecost (Whit Sunday), 49 days after
+// Western Easter, in year.
+func Pentecost(year int) Date {
+	return Easter(year).AddDays(49)
+}
+
+// CorpusChristi returns the date of Corpus Christi, 60 days after Western Easter, in year.
+func CorpusChristi(year int) Date {
+	return Easter(year).AddDays(60)
+}
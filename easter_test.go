@@ -0,0 +1,160 @@
+package dt
+
+import (
+	"bufio"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	// Dates as published in Easter tables, such as those of the Astronomical
+	// Society of South Australia.
+	for _, tt := range []struct {
+		year              int
+		western, orthodox Date
+	}{
+		{1961, Date{1961, time.April, 2, true}, Date{1961, time.April, 9, true}},
+		{2000, Date{2000, time.April, 23, true}, Date{2000, time.April, 30, true}},
+		{2017, Date{2017, time.April, 16, true}, Date{2017, time.April, 16, true}},
+		{2024, Date{2024, time.March, 31, true}, Date{2024, time.May, 5, true}},
+		{2025, Date{2025, time.April, 20, true}, Date{2025, time.April, 20, true}},
+		{2038, Date{2038, time.April, 25, true}, Date{2038, time.April, 25, true}},
+	} {
+		if got := Easter(tt.year); got != tt.western {
+			t.Errorf("Easter(%d) = %v, want %v", tt.year, got, tt.western)
+		}
+		if got := OrthodoxEaster(tt.year); got != tt.orthodox {
+			t.Errorf("OrthodoxEaster(%d) = %v, want %v", tt.year, got, tt.orthodox)
+		}
+	}
+}
+
+// TestEasterPublished checks consecutive years of published Easter tables,
+// such as those of the Astronomical Society of South Australia: Western
+// Easter from 1990 to 2050 and Orthodox Easter from 2001 to 2030.
+func TestEasterPublished(t *testing.T) {
+	western := []string{
+		"04-15", "03-31", "04-19", "04-11", "04-03", "04-16", "04-07", "03-30", "04-12", "04-04",
+		"04-23", "04-15", "03-31", "04-20", "04-11", "03-27", "04-16", "04-08", "03-23", "04-12",
+		"04-04", "04-24", "04-08", "03-31", "04-20", "04-05", "03-27", "04-16", "04-01", "04-21",
+		"04-12", "04-04", "04-17", "04-09", "03-31", "04-20", "04-05", "03-28", "04-16", "04-01",
+		"04-21", "04-13", "03-28", "04-17", "04-09", "03-25", "04-13", "04-05", "04-25", "04-10",
+		"04-01", "04-21", "04-06", "03-29", "04-17", "04-09", "03-25", "04-14", "04-05", "04-18",
+		"04-10",
+	}
+	orthodox := []string{
+		"04-15", "05-05", "04-27", "04-11", "05-01", "04-23", "04-08", "04-27", "04-19", "04-04",
+		"04-24", "04-15", "05-05", "04-20", "04-12", "05-01", "04-16", "04-08", "04-28", "04-19",
+		"05-02", "04-24", "04-16", "05-05", "04-20", "04-12", "05-02", "04-16", "04-08", "04-28",
+	}
+	for _, tt := range []struct {
+		name   string
+		easter func(int) Date
+		first  int
+		dates  []string
+	}{
+		{"Easter", Easter, 1990, western},
+		{"OrthodoxEaster", OrthodoxEaster, 2001, orthodox},
+	} {
+		for i, md := range tt.dates {
+			year := tt.first + i
+			if got, want := tt.easter(year).String(), strconv.Itoa(year)+"-"+md; got != want {
+				t.Errorf("%s(%d) = %s, want %s", tt.name, year, got, want)
+			}
+		}
+	}
+}
+
+// TestEasterTable checks every year of testdata/easter.txt, which was
+// generated with Gauss's Easter algorithm, an implementation independent of
+// the one tested, for the Gregorian years from 1583 to 4099.
+func TestEasterTable(t *testing.T) {
+	f, err := os.Open("testdata/easter.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	n := 0
+	for sc.Scan() {
+		if strings.HasPrefix(sc.Text(), "#") {
+			continue
+		}
+		fields := strings.Fields(sc.Text())
+		year, err := strconv.Atoi(fields[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := Easter(year).String(); got != fields[1] {
+			t.Errorf("Easter(%d) = %s, want %s", year, got, fields[1])
+		}
+		if got := OrthodoxEaster(year).String(); got != fields[2] {
+			t.Errorf("OrthodoxEaster(%d) = %s, want %s", year, got, fields[2])
+		}
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 4099-1583+1 {
+		t.Errorf("read %d years", n)
+	}
+}
+
+func TestEasterAnyYear(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 10000 {
+		year := r.IntN(2_000_000) - 1_000_000
+		e := Easter(year)
+		if e.Year != year || e.Weekday() != time.Sunday || e.Before(Date{year, time.March, 22, true}) || e.After(Date{year, time.April, 25, true}) {
+			t.Fatalf("Easter(%d) = %v", year, e)
+		}
+		// The Gregorian computus repeats every 5,700,000 years.
+		if next := Easter(year + 5_700_000); next.Month != e.Month || next.Day != e.Day {
+			t.Fatalf("Easter(%d) = %v, but Easter(%d) = %v", year, e, year+5_700_000, next)
+		}
+		o := OrthodoxEaster(year)
+		if o.Weekday() != time.Sunday {
+			t.Fatalf("OrthodoxEaster(%d) = %v", year, o)
+		}
+		// The Julian computus repeats every 532 Julian years of 365.25 days.
+		if got := OrthodoxEaster(year + 532).DaysSince(o); got != 532*36525/100 {
+			t.Fatalf("OrthodoxEaster(%d) is %d days after OrthodoxEaster(%d)", year+532, got, year)
+		}
+	}
+}
+
+func TestMovableFeasts(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		f    func(int) Date
+		year int
+		want Date
+		wd   time.Weekday
+	}{
+		{"Ash Wednesday", AshWednesday, 2024, Date{2024, time.February, 14, true}, time.Wednesday},
+		{"Ash Wednesday", AshWednesday, 2025, Date{2025, time.March, 5, true}, time.Wednesday},
+		{"Good Friday", GoodFriday, 2024, Date{2024, time.March, 29, true}, time.Friday},
+		{"Good Friday", GoodFriday, 2025, Date{2025, time.April, 18, true}, time.Friday},
+		{"Ascension", Ascension, 2024, Date{2024, time.May, 9, true}, time.Thursday},
+		{"Ascension", Ascension, 2025, Date{2025, time.May, 29, true}, time.Thursday},
+		{"Pentecost", Pentecost, 2024, Date{2024, time.May, 19, true}, time.Sunday},
+		{"Pentecost", Pentecost, 2025, Date{2025, time.June, 8, true}, time.Sunday},
+		{"Corpus Christi", CorpusChristi, 2024, Date{2024, time.May, 30, true}, time.Thursday},
+		{"Corpus Christi", CorpusChristi, 2025, Date{2025, time.June, 19, true}, time.Thursday},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f(tt.year)
+			if got != tt.want {
+				t.Errorf("%d: got %v, want %v", tt.year, got, tt.want)
+			}
+			if got.Weekday() != tt.wd {
+				t.Errorf("%d: %v is a %v, want %v", tt.year, got, got.Weekday(), tt.wd)
+			}
+		})
+	}
+}
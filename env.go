@@ -0,0 +1,116 @@
+package dt
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DecodeEnv populates the fields of the struct pointed to by v from
+// environment variables. Fields are selected with the env tag, followed by
+// comma-separated options, and may name a default with the envDefault tag:
+//
+//	var cfg struct {
+//		From   dt.Date       `env:"REPORT_FROM,required"`
+//		Cutoff dt.Time       `env:"CUTOFF" envDefault:"18:30"`
+//		Keep   dt.Period     `env:"KEEP,default=P1M"`
+//		Poll   time.Duration `env:"POLL_INTERVAL" envDefault:"30s"`
+//	}
+//	err := dt.DecodeEnv(&cfg, nil)
+//
+// The options are required, which makes DecodeEnv return an error wrapping
+// ErrEnvRequired if the variable is unset and has no default, and
+// default=value, which sets the default in the env tag. Defaults containing
+// commas must use envDefault.
+//
+// Tagged fields must be of a dt type, a time.Duration, or another type whose
+// pointer has a Set(string) error method. Untagged struct fields are decoded
+// recursively. Variables are read with lookup, or os.LookupEnv if lookup is
+// nil. Fields whose variable is unset and have no default are left unchanged.
+func DecodeEnv(v interface{}, lookup func(string) (string, bool)) error {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dt: DecodeEnv requires a non-nil pointer to a struct, got %T", v)
+	}
+	return decodeEnv(rv.Elem(), lookup)
+}
+
+type setter interface {
+	Set(string) error
+}
+
+func decodeEnv(rv reflect.Value, lookup func(string) (string, bool)) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf, fv := rt.Field(i), rv.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag, ok := sf.Tag.Lookup("env")
+		if !ok {
+			if _, isSetter := fv.Addr().Interface().(setter); !isSetter && fv.Kind() == reflect.Struct {
+				if err := decodeEnv(fv, lookup); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		s, ok := fv.Addr().Interface().(setter)
+		if d, isDuration := fv.Addr().Interface().(*time.Duration); isDuration {
+			s, ok = (*durationSetter)(d), true
+		}
+		if !ok {
+			return fmt.Errorf("dt: env %s: unsupported field type %s", name, sf.Type)
+		}
+		required := false
+		def, hasDef := sf.Tag.Lookup("envDefault")
+		for _, opt := range strings.Split(opts, ",") {
+			switch {
+			case opt == "":
+			case opt == "required":
+				required = true
+			case strings.HasPrefix(opt, "default="):
+				def, hasDef = strings.TrimPrefix(opt, "default="), true
+			default:
+				return fmt.Errorf("dt: env %s: unknown option %q", name, opt)
+			}
+		}
+		value, found := lookup(name)
+		if !found {
+			value, found = def, hasDef
+		}
+		if !found {
+			if required {
+				return fmt.Errorf("dt: env %s: %w", name, ErrEnvRequired)
+			}
+			continue
+		}
+		if err := s.Set(value); err != nil {
+			return fmt.Errorf("dt: env %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ErrEnvRequired is wrapped by the error DecodeEnv returns for a required
+// variable that is not set.
+var ErrEnvRequired = errors.New("required variable is not set")
+
+// durationSetter sets a time.Duration with time.ParseDuration.
+type durationSetter time.Duration
+
+func (d *durationSetter) Set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = durationSetter(v)
+	return nil
+}
@@ -0,0 +1,117 @@
+package dt
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDecodeEnv(t *testing.T) {
+	type window struct {
+		Open  Time `env:"OPEN" envDefault:"09:00"`
+		Close Time `env:"CLOSE" envDefault:"17:00"`
+	}
+	type config struct {
+		From    Date      `env:"FROM,required"`
+		Cutoff  Time      `env:"CUTOFF" envDefault:"18:30"`
+		Period  DateRange `env:"PERIOD"`
+		Ignored Date
+		Hours   window
+		name    string
+	}
+	env := map[string]string{
+		"FROM":  "2024-01-01",
+		"CLOSE": "16:00",
+	}
+	lookup := func(k string) (string, bool) {
+		v, ok := env[k]
+		return v, ok
+	}
+
+	cfg := config{Period: DateRange{Date{2024, time.January, 1, true}, Date{2024, time.January, 31, true}}}
+	if err := DecodeEnv(&cfg, lookup); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := config{
+		From:   Date{2024, time.January, 1, true},
+		Cutoff: Time{18, 30, true},
+		Period: cfg.Period,
+		Hours:  window{Open: Time{9, 0, true}, Close: Time{16, 0, true}},
+	}
+	if cfg != want {
+		t.Errorf("expected %+v, got %+v", want, cfg)
+	}
+
+	delete(env, "FROM")
+	if err := DecodeEnv(&cfg, lookup); !errors.Is(err, ErrEnvRequired) {
+		t.Errorf("expected required error, got %v", err)
+	}
+
+	env["FROM"] = "2024-13-01"
+	var pe *ParseError
+	if err := DecodeEnv(&cfg, lookup); !errors.As(err, &pe) {
+		t.Errorf("expected ParseError, got %v", err)
+	}
+
+	var bad struct {
+		N int `env:"N"`
+	}
+	if err := DecodeEnv(&bad, lookup); err == nil {
+		t.Error("expected error for unsupported field type")
+	}
+	if err := DecodeEnv(cfg, lookup); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
+
+func TestDecodeEnvOS(t *testing.T) {
+	t.Setenv("DT_TEST_DATE", "2024-05-15")
+	var cfg struct {
+		D Date `env:"DT_TEST_DATE"`
+	}
+	if err := DecodeEnv(&cfg, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (Date{2024, time.May, 15, true}); cfg.D != want {
+		t.Errorf("expected %v, got %v", want, cfg.D)
+	}
+}
+
+func TestDecodeEnvOptions(t *testing.T) {
+	type config struct {
+		From  Date          `env:"FROM,required,default=2024-01-01"`
+		Keep  Period        `env:"KEEP,default=P1M"`
+		Poll  time.Duration `env:"POLL" envDefault:"30s"`
+		Retry time.Duration `env:"RETRY"`
+	}
+	env := map[string]string{"RETRY": "1m30s"}
+	lookup := func(k string) (string, bool) {
+		v, ok := env[k]
+		return v, ok
+	}
+	var cfg config
+	if err := DecodeEnv(&cfg, lookup); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := config{
+		From:  Date{2024, time.January, 1, true},
+		Keep:  Period{Months: 1},
+		Poll:  30 * time.Second,
+		Retry: 90 * time.Second,
+	}
+	if cfg != want {
+		t.Errorf("expected %+v, got %+v", want, cfg)
+	}
+
+	env["RETRY"] = "soon"
+	if err := DecodeEnv(&cfg, lookup); err == nil {
+		t.Error("expected error for invalid duration")
+	}
+
+	var unknown struct {
+		D Date `env:"D,requird"`
+	}
+	if err := DecodeEnv(&unknown, lookup); err == nil {
+		t.Error("expected error for unknown option")
+	}
+}
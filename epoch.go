@@ -0,0 +1,193 @@
+package dt
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Offsets of the Julian Day Number and the Modified Julian Day from Unix days.
+const (
+	unixToJulianDay         = 2440588
+	unixToModifiedJulianDay = 40587
+)
+
+// UnixDays returns the number of days between 1970-01-01 and d,
+// which is negative for dates before the Unix epoch.
+func (d Date) UnixDays() int {
+	return daysFromCivil(d.Year, d.Month, d.Day)
+}
+
+// DateFromUnixDays returns the Date that is n days after 1970-01-01.
+func DateFromUnixDays(n int) Date {
+	y, m, d := civilFromDays(n)
+	return Date{Year: y, Month: m, Day: d, Valid: true}
+}
+
+// JulianDayNumber returns the Julian Day Number of d, the number of days
+// since noon on January 1st, 4713 BC in the proleptic Julian calendar.
+func (d Date) JulianDayNumber() int {
+	return d.UnixDays() + unixToJulianDay
+}
+
+// DateFromJulianDayNumber returns the Date with the given Julian Day Number.
+func DateFromJulianDayNumber(jdn int) Date {
+	return DateFromUnixDays(jdn - unixToJulianDay)
+}
+
+// ModifiedJulianDay returns the Modified Julian Day of d, the number of days since 1858-11-17.
+func (d Date) ModifiedJulianDay() int {
+	return d.UnixDays() + unixToModifiedJulianDay
+}
+
+// DateFromModifiedJulianDay returns the Date with the given Modified Julian Day.
+func DateFromModifiedJulianDay(mjd int) Date {
+	return DateFromUnixDays(mjd - unixToModifiedJulianDay)
+}
+
+// An ExcelSystem is one of the two date systems used by spreadsheet serial dates.
+type ExcelSystem int
+
+const (
+	// Excel1900 counts 1900-01-01 as serial 1. For compatibility with Lotus 1-2-3
+	// it treats 1900 as a leap year, so serial 60 is the nonexistent 1900-02-29.
+	Excel1900 ExcelSystem = iota
+	// Excel1904 counts 1904-01-01 as serial 0. It was the default on older Macs.
+	Excel1904
+)
+
+// ErrExcelSerial is returned for dates before the start of an Excel date system,
+// and for the serial of the nonexistent 1900-02-29 in the 1900 system.
+var ErrExcelSerial = errors.New("dt: value outside of the Excel serial date range")
+
+// Unix days of the dates Excel serials are counted from.
+var (
+	excel1900Epoch = daysFromCivil(1899, time.December, 30)
+	excel1904Epoch = daysFromCivil(1904, time.January, 1)
+)
+
+// ExcelSerial returns the serial number of d in the given Excel date system.
+func (d Date) ExcelSerial(sys ExcelSystem) (int, error) {
+	days := d.UnixDays()
+	if sys == Excel1904 {
+		if days < excel1904Epoch {
+			return 0, ErrExcelSerial
+		}
+		return days - excel1904Epoch, nil
+	}
+	serial := days - excel1900Epoch
+	switch {
+	case serial < 2:
+		return 0, ErrExcelSerial
+	case serial <= 60:
+		// Before the fake 1900-02-29, serials are off by one.
+		serial--
+	}
+	return serial, nil
+}
+
+// DateFromExcelSerial returns the Date with the given serial number in an Excel date system.
+func DateFromExcelSerial(serial int, sys ExcelSystem) (Date, error) {
+	if sys == Excel1904 {
+		if serial < 0 {
+			return Date{}, ErrExcelSerial
+		}
+		return DateFromUnixDays(excel1904Epoch + serial), nil
+	}
+	switch {
+	case serial < 1, serial == 60:
+		return Date{}, ErrExcelSerial
+	case serial < 60:
+		serial++
+	}
+	return DateFromUnixDays(excel1900Epoch + serial), nil
+}
+
+// ExcelSerial returns the serial number of dt in the given Excel date system,
+// with the time of day as the fractional part.
+func (dt DateTime) ExcelSerial(sys ExcelSystem) (float64, error) {
+	serial, err := dt.Date.ExcelSerial(sys)
+	if err != nil {
+		return 0, err
+	}
+	return float64(serial) + float64(dt.Time.Hour*60+dt.Time.Minute)/minutesPerDay, nil
+}
+
+// DateTimeFromExcelSerial returns the DateTime with the given serial number in an
+// Excel date system. The fractional part is rounded to the nearest minute.
+func DateTimeFromExcelSerial(serial float64, sys ExcelSystem) (DateTime, error) {
+	if math.IsNaN(serial) || math.IsInf(serial, 0) {
+		return DateTime{}, ErrExcelSerial
+	}
+	days := math.Floor(serial)
+	mins := int(math.Round((serial - days) * minutesPerDay))
+	if mins == minutesPerDay {
+		days++
+		mins = 0
+	}
+	d, err := DateFromExcelSerial(int(days), sys)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: Time{Hour: mins / 60, Minute: mins % 60, Valid: true}}, nil
+}
+
+// daysFromCivil returns the number of days between 1970-01-01 and the given
+// date of the proleptic Gregorian calendar. It implements the days_from_civil
+// algorithm described at https://howardhinnant.github.io/date_algorithms.html.
+// Like time.Date, it accepts months and days outside their usual ranges.
+func daysFromCivil(y int, m time.Month, d int) int {
+	if m < time.January || m > time.December {
+		months := int(m) - 1
+		y += floorDiv(months, 12)
+		m = time.Month(months-floorDiv(months, 12)*12) + 1
+	}
+	if m <= time.February {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400                       // [0, 399]
+	doy := (153*((int(m)+9)%12)+2)/5 + d - 1 // [0, 365]
+	doe := yoe*365 + yoe/4 - yoe/100 + doy   // [0, 146096]
+	return era*146097 + doe - 719468
+}
+
+// civilFromDays is the inverse of daysFromCivil.
+func civilFromDays(z int) (int, time.Month, int) {
+	z += 719468
+	era := floorDiv(z, 146097)
+	doe := z - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11], starting in March
+	d := doy - (153*mp+2)/5 + 1
+	m := time.Month(mp + 3)
+	if m > time.December {
+		m -= 12
+	}
+	y := yoe + era*400
+	if m <= time.February {
+		y++
+	}
+	return y, m, d
+}
+
+// weekdayFromDays returns the day of the week n days after 1970-01-01,
+// which was a Thursday.
+func weekdayFromDays(n int) time.Weekday {
+	return time.Weekday(floorMod(n+int(time.Thursday), 7))
+}
+
+// floorDiv returns a/b rounded towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a modulo b, with the sign of b.
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
@@ -0,0 +1,139 @@
+package dt
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUnixDays(t *testing.T) {
+	for _, tt := range []struct {
+		d    Date
+		days int
+	}{
+		{Date{1970, 1, 1, true}, 0},
+		{Date{1969, 12, 31, true}, -1},
+		{Date{2000, 3, 1, true}, 11017},
+		{Date{2024, 2, 29, true}, 19782},
+		{Date{1, 1, 1, true}, -719162},
+		{Date{0, 3, 1, true}, -719468},
+		{Date{-1, 12, 31, true}, -719529},
+	} {
+		if got := tt.d.UnixDays(); got != tt.days {
+			t.Errorf("%v.UnixDays(): got %d, want %d", tt.d, got, tt.days)
+		}
+		if got := DateFromUnixDays(tt.days); got != tt.d {
+			t.Errorf("DateFromUnixDays(%d): got %#v, want %#v", tt.days, got, tt.d)
+		}
+	}
+}
+
+func TestUnixDaysMatchesTime(t *testing.T) {
+	start := time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 400*366; i += 7 {
+		tm := start.AddDate(0, 0, i)
+		d := DateOf(tm)
+		want := int(math.Floor(float64(tm.Unix()) / 86400))
+		if got := d.UnixDays(); got != want {
+			t.Fatalf("%v.UnixDays(): got %d, want %d", d, got, want)
+		}
+		if got := DateFromUnixDays(want); got != d {
+			t.Fatalf("DateFromUnixDays(%d): got %v, want %v", want, got, d)
+		}
+	}
+}
+
+func TestJulianDay(t *testing.T) {
+	for _, tt := range []struct {
+		d        Date
+		jdn, mjd int
+	}{
+		{Date{2000, 1, 1, true}, 2451545, 51544},
+		{Date{1858, 11, 17, true}, 2400001, 0},
+		{Date{-4713, 11, 24, true}, 0, -2400001},
+	} {
+		if got := tt.d.JulianDayNumber(); got != tt.jdn {
+			t.Errorf("%v.JulianDayNumber(): got %d, want %d", tt.d, got, tt.jdn)
+		}
+		if got := DateFromJulianDayNumber(tt.jdn); got != tt.d {
+			t.Errorf("DateFromJulianDayNumber(%d): got %v, want %v", tt.jdn, got, tt.d)
+		}
+		if got := tt.d.ModifiedJulianDay(); got != tt.mjd {
+			t.Errorf("%v.ModifiedJulianDay(): got %d, want %d", tt.d, got, tt.mjd)
+		}
+		if got := DateFromModifiedJulianDay(tt.mjd); got != tt.d {
+			t.Errorf("DateFromModifiedJulianDay(%d): got %v, want %v", tt.mjd, got, tt.d)
+		}
+	}
+}
+
+func TestExcelSerial(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		d      Date
+		sys    ExcelSystem
+		serial int
+	}{
+		{"First day of 1900 system", Date{1900, 1, 1, true}, Excel1900, 1},
+		{"Before the fake leap day", Date{1900, 2, 28, true}, Excel1900, 59},
+		{"After the fake leap day", Date{1900, 3, 1, true}, Excel1900, 61},
+		{"Modern date in 1900 system", Date{2024, 2, 29, true}, Excel1900, 45351},
+		{"First day of 1904 system", Date{1904, 1, 1, true}, Excel1904, 0},
+		{"Modern date in 1904 system", Date{2024, 2, 29, true}, Excel1904, 43889},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.ExcelSerial(tt.sys)
+			if err != nil || got != tt.serial {
+				t.Errorf("expected %d, got %d (error %v)", tt.serial, got, err)
+			}
+			d, err := DateFromExcelSerial(tt.serial, tt.sys)
+			if err != nil || d != tt.d {
+				t.Errorf("expected %v, got %v (error %v)", tt.d, d, err)
+			}
+		})
+	}
+}
+
+func TestExcelSerialOutOfRange(t *testing.T) {
+	if _, err := (Date{1899, 12, 31, true}).ExcelSerial(Excel1900); !errors.Is(err, ErrExcelSerial) {
+		t.Errorf("expected ErrExcelSerial, got %v", err)
+	}
+	if _, err := (Date{1903, 12, 31, true}).ExcelSerial(Excel1904); !errors.Is(err, ErrExcelSerial) {
+		t.Errorf("expected ErrExcelSerial, got %v", err)
+	}
+	for _, serial := range []int{0, 60} {
+		if _, err := DateFromExcelSerial(serial, Excel1900); !errors.Is(err, ErrExcelSerial) {
+			t.Errorf("DateFromExcelSerial(%d): expected ErrExcelSerial, got %v", serial, err)
+		}
+	}
+	if _, err := DateFromExcelSerial(-1, Excel1904); !errors.Is(err, ErrExcelSerial) {
+		t.Errorf("expected ErrExcelSerial, got %v", err)
+	}
+}
+
+func TestDateTimeExcelSerial(t *testing.T) {
+	dt := DateTime{Date{2024, 2, 29, true}, Time{18, 0, true}}
+	got, err := dt.ExcelSerial(Excel1900)
+	if err != nil || got != 45351.75 {
+		t.Errorf("expected 45351.75, got %v (error %v)", got, err)
+	}
+
+	for _, tt := range []struct {
+		serial float64
+		want   DateTime
+	}{
+		{45351.75, dt},
+		{45351.5208333, DateTime{Date{2024, 2, 29, true}, Time{12, 30, true}}},
+		{45351.9999999, DateTime{Date{2024, 3, 1, true}, Time{0, 0, true}}},
+	} {
+		got, err := DateTimeFromExcelSerial(tt.serial, Excel1900)
+		if err != nil || got != tt.want {
+			t.Errorf("DateTimeFromExcelSerial(%v): expected %v, got %v (error %v)", tt.serial, tt.want, got, err)
+		}
+	}
+
+	if _, err := DateTimeFromExcelSerial(math.NaN(), Excel1900); !errors.Is(err, ErrExcelSerial) {
+		t.Errorf("expected ErrExcelSerial, got %v", err)
+	}
+}
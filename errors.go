@@ -0,0 +1,111 @@
+package dt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A RangeError is returned when a Date, Time or DateTime marked as Valid
+// holds a field outside of its calendar range, such as February 30th or hour 25.
+type RangeError struct {
+	Field string // Name of the offending field, e.g. "day" or "hour".
+	Value int    // Value held by the field.
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("dt: %s %d out of range", e.Field, e.Value)
+}
+
+// A ParseError describes a failure to parse a Date, Time or DateTime from text.
+// It is returned by the Parse functions as well as UnmarshalText and Scan.
+type ParseError struct {
+	Type     string   // Type being parsed, e.g. "date", "time" or "datetime".
+	Input    string   // The text that failed to parse.
+	Expected []string // Accepted formats, e.g. "YYYY-MM-DD".
+	Offset   int      // Byte offset in Input at which parsing failed.
+	Field    string   // Field at Offset, e.g. "month"; empty for separators and trailing text.
+	Err      error    // Underlying error, usually a *time.ParseError.
+}
+
+func (e *ParseError) Error() string {
+	var reason string
+	switch {
+	case e.Input == "":
+		return fmt.Sprintf("dt: cannot parse empty string as %s (expected %s)", e.Type, strings.Join(e.Expected, " or "))
+	case e.Field != "":
+		reason = "invalid " + e.Field
+	case e.Offset >= len(e.Input):
+		reason = "input too short"
+	default:
+		reason = fmt.Sprintf("unexpected %q", e.Input[e.Offset:])
+	}
+	return fmt.Sprintf("dt: cannot parse %q as %s: %s at offset %d (expected %s)",
+		e.Input, e.Type, reason, e.Offset, strings.Join(e.Expected, " or "))
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	layoutFields = map[string]string{
+		"2006":   "year",
+		"01":     "month",
+		"02":     "day",
+		"15":     "hour",
+		"04":     "minute",
+		"05":     "second",
+		"Z07:00": "offset",
+		"Z07":    "offset",
+	}
+	fieldLayouts = map[string]string{
+		"year":   "2006",
+		"month":  "01",
+		"day":    "02",
+		"hour":   "15",
+		"minute": "04",
+		"second": "05",
+	}
+	formatReplacer = strings.NewReplacer("Z07:00", "±hh:mm", "Z07", "±hh", "2006", "YYYY", "01", "MM", "02", "DD", "15", "hh", "04", "mm", "05", "ss")
+)
+
+// parseLayouts parses s using the first matching layout. If none match, it
+// returns a *ParseError for the layout that got furthest into s.
+func parseLayouts(typ, s string, layouts ...string) (time.Time, error) {
+	var best *ParseError
+	for _, l := range layouts {
+		t, err := time.Parse(l, s)
+		if err == nil {
+			return t, nil
+		}
+		if pe := newParseError(typ, s, l, err); best == nil || pe.Offset > best.Offset {
+			best = pe
+		}
+	}
+	best.Expected = make([]string, len(layouts))
+	for i, l := range layouts {
+		best.Expected[i] = formatReplacer.Replace(l)
+	}
+	return time.Time{}, best
+}
+
+func newParseError(typ, s, layout string, err error) *ParseError {
+	pe := &ParseError{Type: typ, Input: s, Err: err}
+	var tpe *time.ParseError
+	if !errors.As(err, &tpe) {
+		return pe
+	}
+	pe.Offset = len(tpe.Value) - len(tpe.ValueElem)
+	pe.Field = layoutFields[tpe.LayoutElem]
+	if strings.HasSuffix(tpe.Message, " out of range") {
+		// Range errors are reported after the element has been consumed,
+		// so locate the element in the layout instead. All layouts used
+		// by this package are fixed-width.
+		pe.Field = strings.TrimSuffix(strings.TrimPrefix(tpe.Message, ": "), " out of range")
+		pe.Offset = strings.Index(layout, fieldLayouts[pe.Field])
+	}
+	return pe
+}
@@ -0,0 +1,140 @@
+package dt
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		name  string
+		parse func(string) error
+		req   string
+		want  ParseError
+	}{
+		{
+			name:  "Empty date",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			want:  ParseError{Type: "date", Expected: []string{"YYYY-MM-DD"}, Field: "year"},
+		},
+		{
+			name:  "Month out of range",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			req:   "2019-23-11",
+			want:  ParseError{Type: "date", Input: "2019-23-11", Expected: []string{"YYYY-MM-DD"}, Offset: 5, Field: "month"},
+		},
+		{
+			name:  "Day out of range",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			req:   "2019-02-30",
+			want:  ParseError{Type: "date", Input: "2019-02-30", Expected: []string{"YYYY-MM-DD"}, Offset: 8, Field: "day"},
+		},
+		{
+			name:  "Wrong separator",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			req:   "2019/02/03",
+			want:  ParseError{Type: "date", Input: "2019/02/03", Expected: []string{"YYYY-MM-DD"}, Offset: 4},
+		},
+		{
+			name:  "Extra text",
+			parse: func(s string) error { _, err := ParseDate(s); return err },
+			req:   "2016-01-02x",
+			want:  ParseError{Type: "date", Input: "2016-01-02x", Expected: []string{"YYYY-MM-DD"}, Offset: 10},
+		},
+		{
+			name:  "Hour out of range",
+			parse: func(s string) error { _, err := ParseTime(s); return err },
+			req:   "33:33:33",
+			want:  ParseError{Type: "time", Input: "33:33:33", Expected: []string{"hh:mm", "hh:mm:ss"}, Field: "hour"},
+		},
+		{
+			name:  "Minute out of range",
+			parse: func(s string) error { _, err := ParseTime(s); return err },
+			req:   "12:61",
+			want:  ParseError{Type: "time", Input: "12:61", Expected: []string{"hh:mm", "hh:mm:ss"}, Offset: 3, Field: "minute"},
+		},
+		{
+			name:  "Furthest datetime layout",
+			parse: func(s string) error { _, err := ParseDateTime(s); return err },
+			req:   "2016-03-22T13:2x",
+			want: ParseError{
+				Type:     "datetime",
+				Input:    "2016-03-22T13:2x",
+				Expected: []string{"YYYY-MM-DDThh:mm", "YYYY-MM-DDThh:mm:ss", "YYYY-MM-DD hh:mm:ss", "YYYY-MM-DD hh:mm"},
+				Offset:   14,
+				Field:    "minute",
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var pe *ParseError
+			if err := tt.parse(tt.req); !errors.As(err, &pe) {
+				t.Fatalf("expected ParseError, got %v", err)
+			}
+			var tpe *time.ParseError
+			if !errors.As(pe, &tpe) {
+				t.Errorf("expected wrapped time.ParseError, got %v", pe.Err)
+			}
+			pe.Err = nil
+			if !reflect.DeepEqual(*pe, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, *pe)
+			}
+		})
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	cases := []struct {
+		name string
+		err  ParseError
+		want string
+	}{
+		{
+			name: "Empty input",
+			err:  ParseError{Type: "date", Expected: []string{"YYYY-MM-DD"}, Field: "year"},
+			want: `dt: cannot parse empty string as date (expected YYYY-MM-DD)`,
+		},
+		{
+			name: "Invalid field",
+			err:  ParseError{Type: "date", Input: "2019-23-11", Expected: []string{"YYYY-MM-DD"}, Offset: 5, Field: "month"},
+			want: `dt: cannot parse "2019-23-11" as date: invalid month at offset 5 (expected YYYY-MM-DD)`,
+		},
+		{
+			name: "Unexpected text",
+			err:  ParseError{Type: "time", Input: "12-30", Expected: []string{"hh:mm", "hh:mm:ss"}, Offset: 2},
+			want: `dt: cannot parse "12-30" as time: unexpected "-30" at offset 2 (expected hh:mm or hh:mm:ss)`,
+		},
+		{
+			name: "Too short",
+			err:  ParseError{Type: "time", Input: "12", Expected: []string{"hh:mm"}, Offset: 2},
+			want: `dt: cannot parse "12" as time: input too short at offset 2 (expected hh:mm)`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseErrorFromDecoding(t *testing.T) {
+	var pe *ParseError
+	var req dateReq
+	if err := json.Unmarshal([]byte(`{"date":"2019-02-30"}`), &req); !errors.As(err, &pe) {
+		t.Errorf("expected ParseError from json.Unmarshal, got %v", err)
+	}
+	var tm Time
+	if err := tm.Scan("25:00"); !errors.As(err, &pe) {
+		t.Errorf("expected ParseError from Scan, got %v", err)
+	}
+	var dt DateTime
+	if err := dt.UnmarshalText([]byte("2019-02-03")); !errors.As(err, &pe) {
+		t.Errorf("expected ParseError from UnmarshalText, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package dt
+
+// The Set and Type methods make pointers to dt types usable as flag.Value and
+// as github.com/spf13/pflag.Value:
+//
+//	var from dt.Date
+//	flag.Var(&from, "from", "first day of the report")
+//
+// Setting an empty string resets the value so it is no longer Valid.
+
+// Set implements flag.Value, parsing s with ParseDate.
+func (d *Date) Set(s string) error {
+	return set(d, s, ParseDate)
+}
+
+// Type returns "date", implementing pflag.Value.
+func (*Date) Type() string { return "date" }
+
+// Set implements flag.Value, parsing s with ParseTime.
+func (t *Time) Set(s string) error {
+	return set(t, s, ParseTime)
+}
+
+// Type returns "time", implementing pflag.Value.
+func (*Time) Type() string { return "time" }
+
+// Set implements flag.Value, parsing s with ParseDateTime.
+func (dt *DateTime) Set(s string) error {
+	return set(dt, s, ParseDateTime)
+}
+
+// Type returns "datetime", implementing pflag.Value.
+func (*DateTime) Type() string { return "datetime" }
+
+// Set implements flag.Value, parsing s with ParseOffsetDateTime.
+func (odt *OffsetDateTime) Set(s string) error {
+	return set(odt, s, ParseOffsetDateTime)
+}
+
+// Type returns "offsetdatetime", implementing pflag.Value.
+func (*OffsetDateTime) Type() string { return "offsetdatetime" }
+
+// Set implements flag.Value, parsing s with ParseDateRange.
+func (r *DateRange) Set(s string) error {
+	return set(r, s, ParseDateRange)
+}
+
+// Type returns "daterange", implementing pflag.Value.
+func (*DateRange) Type() string { return "daterange" }
+
+// Set implements flag.Value, parsing s with ParseDateTimeRange.
+func (r *DateTimeRange) Set(s string) error {
+	return set(r, s, ParseDateTimeRange)
+}
+
+// Type returns "datetimerange", implementing pflag.Value.
+func (*DateTimeRange) Type() string { return "datetimerange" }
+
+// Set implements flag.Value, parsing s with ParsePeriod.
+func (p *Period) Set(s string) error {
+	return set(p, s, ParsePeriod)
+}
+
+// Type returns "period", implementing pflag.Value.
+func (*Period) Type() string { return "period" }
+
+// set stores the result of parse(s) in v, or the zero value if s is empty.
+// v is left unchanged on error.
+func set[T any](v *T, s string, parse func(string) (T, error)) error {
+	if s == "" {
+		var zero T
+		*v = zero
+		return nil
+	}
+	p, err := parse(s)
+	if err != nil {
+		return err
+	}
+	*v = p
+	return nil
+}
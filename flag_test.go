@@ -0,0 +1,90 @@
+package dt
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestFlagValue(t *testing.T) {
+	var (
+		d   Date
+		tm  Time
+		dt  DateTime
+		odt OffsetDateTime
+		r   DateRange
+		tr  DateTimeRange
+		p   Period
+	)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&d, "from", "")
+	fs.Var(&tm, "cutoff", "")
+	fs.Var(&dt, "at", "")
+	fs.Var(&odt, "since", "")
+	fs.Var(&r, "period", "")
+	fs.Var(&tr, "window", "")
+	fs.Var(&p, "keep", "")
+	err := fs.Parse([]string{
+		"--from", "2024-01-01",
+		"--cutoff", "18:30",
+		"--at=2024-01-01T09:00",
+		"--since", "2024-01-01T09:00:00+02:00",
+		"--period", "2024-01-01/2024-03-31",
+		"--window", "2024-01-01T09:00/2024-01-01T17:30",
+		"--keep", "P1Y6M",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, tt := range []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"Date", d, Date{2024, time.January, 1, true}},
+		{"Time", tm, Time{18, 30, true}},
+		{"DateTime", dt, DateTime{Date{2024, time.January, 1, true}, Time{9, 0, true}}},
+		{"OffsetDateTime", odt, OffsetDateTime{DateTime{Date{2024, time.January, 1, true}, Time{9, 0, true}}, 7200}},
+		{"DateRange", r, DateRange{Date{2024, time.January, 1, true}, Date{2024, time.March, 31, true}}},
+		{"DateTimeRange", tr, DateTimeRange{DateTime{Date{2024, time.January, 1, true}, Time{9, 0, true}}, DateTime{Date{2024, time.January, 1, true}, Time{17, 30, true}}}},
+		{"Period", p, Period{Years: 1, Months: 6}},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+	if got := fs.Lookup("cutoff").Value.String(); got != "18:30" {
+		t.Errorf("expected flag to print 18:30, got %q", got)
+	}
+}
+
+func TestFlagSet(t *testing.T) {
+	d := Date{2024, time.January, 1, true}
+	if err := d.Set("2024-02-30"); err == nil {
+		t.Error("expected error for invalid date")
+	}
+	if want := (Date{2024, time.January, 1, true}); d != want {
+		t.Errorf("expected %v to be unchanged on error, got %v", want, d)
+	}
+	if err := d.Set(""); err != nil || d != (Date{}) {
+		t.Errorf("expected empty string to reset date, got %v (error %v)", d, err)
+	}
+	for _, tt := range []struct {
+		v    interface{ Type() string }
+		want string
+	}{
+		{new(Date), "date"},
+		{new(Time), "time"},
+		{new(DateTime), "datetime"},
+		{new(OffsetDateTime), "offsetdatetime"},
+		{new(DateRange), "daterange"},
+		{new(DateTimeRange), "datetimerange"},
+		{new(Period), "period"},
+	} {
+		if got := tt.v.Type(); got != tt.want {
+			t.Errorf("expected type %q, got %q", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package dt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// debugFormatter is implemented by the types of this package to support
+// fmt.Formatter through formatValue.
+type debugFormatter interface {
+	String() string
+	// rawString formats the fields regardless of validity.
+	rawString() string
+	// problem describes why the value is not valid, or returns "".
+	problem() string
+	// goString returns the value as a Go literal.
+	goString() string
+}
+
+// formatValue implements fmt.Formatter for v:
+//
+//   - %v and %s format v as its String method does.
+//   - %+v formats the fields even when v is not valid, followed by the reason
+//     in parentheses, e.g. "2023-02-30 (day 30 out of range)".
+//   - %#v formats v as a Go literal.
+//   - %q formats v as a double-quoted String.
+func formatValue(f fmt.State, verb rune, v debugFormatter) {
+	var s string
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		s = v.goString()
+	case verb == 'v' && f.Flag('+'):
+		s = v.rawString()
+		if p := v.problem(); p != "" {
+			s += " (" + p + ")"
+		}
+	case verb == 'v' || verb == 's' || verb == 'q':
+		s = v.String()
+	default:
+		fmt.Fprintf(f, "%%!%c(%T=%s)", verb, v, v.String())
+		return
+	}
+	if verb == 'q' {
+		fmt.Fprintf(f, fmt.FormatString(f, 'q'), s)
+		return
+	}
+	width, ok := f.Width()
+	if !ok {
+		fmt.Fprint(f, s)
+		return
+	}
+	if f.Flag('-') {
+		fmt.Fprintf(f, "%-*s", width, s)
+		return
+	}
+	fmt.Fprintf(f, "%*s", width, s)
+}
+
+// problem describes why a value marked valid by valid is not, given the
+// result of its check method.
+func problem(valid bool, err error) string {
+	if !valid {
+		return "invalid"
+	}
+	if err != nil {
+		return strings.TrimPrefix(err.Error(), "dt: ")
+	}
+	return ""
+}
+
+// Format implements fmt.Formatter. See formatValue for the supported verbs.
+func (d Date) Format(f fmt.State, verb rune) { formatValue(f, verb, d) }
+
+func (d Date) rawString() string { return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day) }
+func (d Date) problem() string   { return problem(d.Valid, d.check()) }
+func (d Date) goString() string {
+	return fmt.Sprintf("dt.Date{Year:%d, Month:%s, Day:%d, Valid:%t}", d.Year, goMonth(int(d.Month)), d.Day, d.Valid)
+}
+
+// Format implements fmt.Formatter. See formatValue for the supported verbs.
+func (t Time) Format(f fmt.State, verb rune) { formatValue(f, verb, t) }
+
+func (t Time) rawString() string { return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute) }
+func (t Time) problem() string   { return problem(t.Valid, t.check()) }
+func (t Time) goString() string {
+	return fmt.Sprintf("dt.Time{Hour:%d, Minute:%d, Valid:%t}", t.Hour, t.Minute, t.Valid)
+}
+
+// Format implements fmt.Formatter. See formatValue for the supported verbs.
+func (dt DateTime) Format(f fmt.State, verb rune) { formatValue(f, verb, dt) }
+
+func (dt DateTime) rawString() string { return dt.Date.rawString() + "T" + dt.Time.rawString() }
+func (dt DateTime) problem() string {
+	return problem(dt.Date.Valid && dt.Time.Valid, dt.check())
+}
+func (dt DateTime) goString() string {
+	return fmt.Sprintf("dt.DateTime{Date:%s, Time:%s}", dt.Date.goString(), dt.Time.goString())
+}
+
+// Format implements fmt.Formatter. See formatValue for the supported verbs.
+func (odt OffsetDateTime) Format(f fmt.State, verb rune) { formatValue(f, verb, odt) }
+
+func (odt OffsetDateTime) rawString() string {
+	sign, offset := '+', odt.Offset
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("%s%c%02d:%02d", odt.DateTime.rawString(), sign, offset/3600, offset%3600/60)
+}
+func (odt OffsetDateTime) problem() string { return odt.DateTime.problem() }
+func (odt OffsetDateTime) goString() string {
+	return fmt.Sprintf("dt.OffsetDateTime{DateTime:%s, Offset:%d}", odt.DateTime.goString(), odt.Offset)
+}
+
+// Format implements fmt.Formatter. See formatValue for the supported verbs.
+func (r DateRange) Format(f fmt.State, verb rune) { formatValue(f, verb, r) }
+
+func (r DateRange) rawString() string { return r.Start.rawString() + "/" + r.End.rawString() }
+func (r DateRange) problem() string {
+	if err := r.check(); err != nil {
+		return strings.TrimPrefix(err.Error(), "dt: ")
+	}
+	return ""
+}
+func (r DateRange) goString() string {
+	return fmt.Sprintf("dt.DateRange{Start:%s, End:%s}", r.Start.goString(), r.End.goString())
+}
+
+// Format implements fmt.Formatter. See formatValue for the supported verbs.
+func (r DateTimeRange) Format(f fmt.State, verb rune) { formatValue(f, verb, r) }
+
+func (r DateTimeRange) rawString() string { return r.Start.rawString() + "/" + r.End.rawString() }
+func (r DateTimeRange) problem() string {
+	if err := r.check(); err != nil {
+		return strings.TrimPrefix(err.Error(), "dt: ")
+	}
+	return ""
+}
+func (r DateTimeRange) goString() string {
+	return fmt.Sprintf("dt.DateTimeRange{Start:%s, End:%s}", r.Start.goString(), r.End.goString())
+}
+
+// goMonth returns m as a Go expression, e.g. "time.May".
+func goMonth(m int) string {
+	if m < 1 || m > 12 {
+		return fmt.Sprintf("%d", m)
+	}
+	return "time." + time.Month(m).String()
+}
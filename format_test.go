@@ -0,0 +1,48 @@
+package dt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	d := Date{2024, time.May, 15, true}
+	tm := Time{18, 30, true}
+	cases := []struct {
+		format string
+		value  interface{}
+		want   string
+	}{
+		{"%v", d, "2024-05-15"},
+		{"%s", tm, "18:30"},
+		{"%q", DateTime{d, tm}, `"2024-05-15T18:30"`},
+		{"%12v|", d, "  2024-05-15|"},
+		{"%-12s|", d, "2024-05-15  |"},
+		{"%v", Date{}, ""},
+		{"%+v", d, "2024-05-15"},
+		{"%+v", Date{}, "0000-00-00 (invalid)"},
+		{"%+v", Date{2023, time.February, 30, true}, "2023-02-30 (day 30 out of range)"},
+		{"%+v", Time{25, 0, true}, "25:00 (hour 25 out of range)"},
+		{"%+v", DateTime{Date: d}, "2024-05-15T00:00 (invalid)"},
+		{"%+v", OffsetDateTime{DateTime{d, tm}, -9000}, "2024-05-15T18:30-02:30"},
+		{"%+v", DateRange{d, Date{2024, time.May, 1, true}}, "2024-05-15/2024-05-01 (date range end 2024-05-01 is before start 2024-05-15)"},
+		{"%+v", DateTimeRange{DateTime{d, tm}, DateTime{d, Time{18, 0, true}}}, "2024-05-15T18:30/2024-05-15T18:00 (datetime range end 2024-05-15T18:00 is before start 2024-05-15T18:30)"},
+		{"%+v", DateTimeRange{Start: DateTime{d, tm}}, "2024-05-15T18:30/0000-00-00T00:00 (datetime range requires valid start and end)"},
+		{"%q", DateTimeRange{DateTime{d, tm}, DateTime{d, Time{20, 0, true}}}, `"2024-05-15T18:30/2024-05-15T20:00"`},
+		{"%#v", d, "dt.Date{Year:2024, Month:time.May, Day:15, Valid:true}"},
+		{"%#v", Date{}, "dt.Date{Year:0, Month:0, Day:0, Valid:false}"},
+		{"%#v", DateTime{d, tm}, "dt.DateTime{Date:dt.Date{Year:2024, Month:time.May, Day:15, Valid:true}, Time:dt.Time{Hour:18, Minute:30, Valid:true}}"},
+		{"%#v", OffsetDateTime{Offset: 3600}, "dt.OffsetDateTime{DateTime:dt.DateTime{Date:dt.Date{Year:0, Month:0, Day:0, Valid:false}, Time:dt.Time{Hour:0, Minute:0, Valid:false}}, Offset:3600}"},
+		{"%#v", DateRange{}, "dt.DateRange{Start:dt.Date{Year:0, Month:0, Day:0, Valid:false}, End:dt.Date{Year:0, Month:0, Day:0, Valid:false}}"},
+		{"%#v", DateTimeRange{Start: DateTime{d, tm}}, "dt.DateTimeRange{Start:dt.DateTime{Date:dt.Date{Year:2024, Month:time.May, Day:15, Valid:true}, Time:dt.Time{Hour:18, Minute:30, Valid:true}}, End:dt.DateTime{Date:dt.Date{Year:0, Month:0, Day:0, Valid:false}, Time:dt.Time{Hour:0, Minute:0, Valid:false}}}"},
+		{"%d", tm, "%!d(dt.Time=18:30)"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.value); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package holidays
+
+import "time"
+
+func init() {
+	for _, c := range []*Country{unitedStates, unitedKingdom, germany, france, croatia, serbia, turkey} {
+		Register(c)
+	}
+}
+
+// unitedStates holds the federal holidays of 5 U.S.C. 6103. Before the
+// Uniform Monday Holiday Act took effect in 1971, Washington's Birthday,
+// Memorial Day and Veterans Day are given on their fixed dates, and Columbus
+// Day is not a holiday. From 1971 to 1977, Veterans Day was the fourth Monday
+// in October.
+var unitedStates = &Country{
+	Code: "US",
+	Name: "United States",
+	Rules: []Rule{
+		{Name: "New Year's Day", Dates: Fixed(time.January, 1), Shift: NearestWeekday},
+		{Name: "Birthday of Martin Luther King, Jr.", Dates: NthWeekday(time.January, 3, time.Monday), Shift: NearestWeekday, From: 1986},
+		{Name: "Washington's Birthday", Dates: Fixed(time.February, 22), Shift: NearestWeekday, To: 1970},
+		{Name: "Washington's Birthday", Dates: NthWeekday(time.February, 3, time.Monday), Shift: NearestWeekday, From: 1971},
+		{Name: "Memorial Day", Dates: Fixed(time.May, 30), Shift: NearestWeekday, To: 1970},
+		{Name: "Memorial Day", Dates: NthWeekday(time.May, -1, time.Monday), Shift: NearestWeekday, From: 1971},
+		{Name: "Juneteenth National Independence Day", Dates: Fixed(time.June, 19), Shift: NearestWeekday, From: 2021},
+		{Name: "Independence Day", Dates: Fixed(time.July, 4), Shift: NearestWeekday},
+		{Name: "Labor Day", Dates: NthWeekday(time.September, 1, time.Monday), Shift: NearestWeekday},
+		{Name: "Columbus Day", Dates: NthWeekday(time.October, 2, time.Monday), Shift: NearestWeekday, From: 1971},
+		{Name: "Veterans Day", Dates: NthWeekday(time.October, 4, time.Monday), Shift: NearestWeekday, From: 1971, To: 1977},
+		{Name: "Veterans Day", Dates: Fixed(time.November, 11), Shift: NearestWeekday, To: 1970},
+		{Name: "Veterans Day", Dates: Fixed(time.November, 11), Shift: NearestWeekday, From: 1978},
+		{Name: "Thanksgiving Day", Dates: NthWeekday(time.November, 4, time.Thursday), Shift: NearestWeekday},
+		{Name: "Christmas Day", Dates: Fixed(time.December, 25), Shift: NearestWeekday},
+	},
+}
+
+// unitedKingdom holds the bank holidays of the Banking and Financial
+// Dealings Act 1971, without the one-off changes made by proclamation.
+var unitedKingdom = &Country{
+	Code: "GB",
+	Name: "United Kingdom",
+	Subdivisions: map[string]string{
+		"ENG": "England",
+		"WLS": "Wales",
+		"SCT": "Scotland",
+		"NIR": "Northern Ireland",
+	},
+	Rules: []Rule{
+		{Name: "New Year's Day", Type: Bank, Dates: Fixed(time.January, 1), Shift: NextWeekday},
+		{Name: "2nd January", Type: Bank, Dates: Fixed(time.January, 2), Shift: NextWeekday, Subdivisions: []string{"SCT"}},
+		{Name: "St Patrick's Day", Type: Bank, Dates: Fixed(time.March, 17), Shift: NextWeekday, Subdivisions: []string{"NIR"}},
+		{Name: "Good Friday", Type: Bank, Dates: EasterOffset(-2)},
+		{Name: "Easter Monday", Type: Bank, Dates: EasterOffset(1), Subdivisions: []string{"ENG", "WLS", "NIR"}},
+		{Name: "Early May bank holiday", Type: Bank, Dates: NthWeekday(time.May, 1, time.Monday), From: 1978},
+		{Name: "Spring bank holiday", Type: Bank, Dates: NthWeekday(time.May, -1, time.Monday)},
+		{Name: "Battle of the Boyne", Type: Bank, Dates: Fixed(time.July, 12), Shift: NextWeekday, Subdivisions: []string{"NIR"}},
+		{Name: "Summer bank holiday", Type: Bank, Dates: NthWeekday(time.August, 1, time.Monday), Subdivisions: []string{"SCT"}},
+		{Name: "Summer bank holiday", Type: Bank, Dates: NthWeekday(time.August, -1, time.Monday), Subdivisions: []string{"ENG", "WLS", "NIR"}},
+		{Name: "St Andrew's Day", Type: Bank, Dates: Fixed(time.November, 30), Shift: NextWeekday, Subdivisions: []string{"SCT"}, From: 2007},
+		{Name: "Christmas Day", Type: Bank, Dates: Fixed(time.December, 25), Shift: NextWeekday},
+		{Name: "Boxing Day", Type: Bank, Dates: Fixed(time.December, 26), Shift: NextWeekday},
+	},
+}
+
+// germany holds the public holidays of the federal and state laws since
+// reunification.
+var germany = &Country{
+	Code: "DE",
+	Name: "Germany",
+	Subdivisions: map[string]string{
+		"BW": "Baden-Württemberg",
+		"BY": "Bavaria",
+		"BE": "Berlin",
+		"BB": "Brandenburg",
+		"HB": "Bremen",
+		"HH": "Hamburg",
+		"HE": "Hesse",
+		"MV": "Mecklenburg-Western Pomerania",
+		"NI": "Lower Saxony",
+		"NW": "North Rhine-Westphalia",
+		"RP": "Rhineland-Palatinate",
+		"SL": "Saarland",
+		"SN": "Saxony",
+		"ST": "Saxony-Anhalt",
+		"SH": "Schleswig-Holstein",
+		"TH": "Thuringia",
+	},
+	Rules: []Rule{
+		{Name: "New Year's Day", Dates: Fixed(time.January, 1)},
+		{Name: "Epiphany", Dates: Fixed(time.January, 6), Subdivisions: []string{"BW", "BY", "ST"}},
+		{Name: "International Women's Day", Dates: Fixed(time.March, 8), Subdivisions: []string{"BE"}, From: 2019},
+		{Name: "International Women's Day", Dates: Fixed(time.March, 8), Subdivisions: []string{"MV"}, From: 2023},
+		{Name: "Good Friday", Dates: EasterOffset(-2)},
+		{Name: "Easter Sunday", Dates: EasterOffset(0), Subdivisions: []string{"BB"}},
+		{Name: "Easter Monday", Dates: EasterOffset(1)},
+		{Name: "Labour Day", Dates: Fixed(time.May, 1)},
+		{Name: "Ascension Day", Dates: EasterOffset(39)},
+		{Name: "Whit Sunday", Dates: EasterOffset(49), Subdivisions: []string{"BB"}},
+		{Name: "Whit Monday", Dates: EasterOffset(50)},
+		{Name: "Corpus Christi", Dates: EasterOffset(60), Subdivisions: []string{"BW", "BY", "HE", "NW", "RP", "SL"}},
+		{Name: "Assumption Day", Dates: Fixed(time.August, 15), Subdivisions: []string{"SL"}},
+		{Name: "World Children's Day", Dates: Fixed(time.September, 20), Subdivisions: []string{"TH"}, From: 2019},
+		{Name: "German Unity Day", Dates: Fixed(time.October, 3), From: 1990},
+		{Name: "Reformation Day", Dates: Fixed(time.October, 31), Subdivisions: []string{"BB", "MV", "SN", "ST", "TH"}},
+		{Name: "Reformation Day", Dates: Fixed(time.October, 31), Subdivisions: []string{"HB", "HH", "NI", "SH"}, From: 2018},
+		{Name: "All Saints' Day", Dates: Fixed(time.November, 1), Subdivisions: []string{"BW", "BY", "NW", "RP", "SL"}},
+		{Name: "Repentance and Prayer Day", Dates: WeekdayBefore(time.November, 23, time.Wednesday), Subdivisions: []string{"SN"}},
+		{Name: "Christmas Day", Dates: Fixed(time.December, 25)},
+		{Name: "St Stephen's Day", Dates: Fixed(time.December, 26)},
+	},
+}
+
+// france holds the public holidays of the Labour Code, with the additional
+// holidays of Alsace and Moselle.
+var france = &Country{
+	Code: "FR",
+	Name: "France",
+	Subdivisions: map[string]string{
+		"57": "Moselle",
+		"67": "Bas-Rhin",
+		"68": "Haut-Rhin",
+	},
+	Rules: []Rule{
+		{Name: "New Year's Day", Dates: Fixed(time.January, 1)},
+		{Name: "Good Friday", Dates: EasterOffset(-2), Subdivisions: []string{"57", "67", "68"}},
+		{Name: "Easter Monday", Dates: EasterOffset(1)},
+		{Name: "Labour Day", Dates: Fixed(time.May, 1)},
+		{Name: "Victory in Europe Day", Dates: Fixed(time.May, 8)},
+		{Name: "Ascension Day", Dates: EasterOffset(39)},
+		{Name: "Whit Monday", Dates: EasterOffset(50)},
+		{Name: "Bastille Day", Dates: Fixed(time.July, 14)},
+		{Name: "Assumption Day", Dates: Fixed(time.August, 15)},
+		{Name: "All Saints' Day", Dates: Fixed(time.November, 1)},
+		{Name: "Armistice Day", Dates: Fixed(time.November, 11)},
+		{Name: "Christmas Day", Dates: Fixed(time.December, 25)},
+		{Name: "St Stephen's Day", Dates: Fixed(time.December, 26), Subdivisions: []string{"57", "67", "68"}},
+	},
+}
+
+// croatia holds the public holidays of the Holidays Act, including the
+// changes to Statehood Day and Remembrance Day made in 2020.
+var croatia = &Country{
+	Code: "HR",
+	Name: "Croatia",
+	Rules: []Rule{
+		{Name: "New Year's Day", Dates: Fixed(time.January, 1)},
+		{Name: "Epiphany", Dates: Fixed(time.January, 6)},
+		{Name: "Easter Sunday", Dates: EasterOffset(0)},
+		{Name: "Easter Monday", Dates: EasterOffset(1)},
+		{Name: "Labour Day", Dates: Fixed(time.May, 1)},
+		{Name: "Statehood Day", Dates: Fixed(time.May, 30), From: 2020},
+		{Name: "Corpus Christi", Dates: EasterOffset(60)},
+		{Name: "Anti-Fascist Struggle Day", Dates: Fixed(time.June, 22)},
+		{Name: "Statehood Day", Dates: Fixed(time.June, 25), To: 2019},
+		{Name: "Victory and Homeland Thanksgiving Day", Dates: Fixed(time.August, 5)},
+		{Name: "Assumption Day", Dates: Fixed(time.August, 15)},
+		{Name: "Independence Day", Dates: Fixed(time.October, 8), To: 2019},
+		{Name: "All Saints' Day", Dates: Fixed(time.November, 1)},
+		{Name: "Remembrance Day", Dates: Fixed(time.November, 18), From: 2020},
+		{Name: "Christmas Day", Dates: Fixed(time.December, 25)},
+		{Name: "St Stephen's Day", Dates: Fixed(time.December, 26)},
+	},
+}
+
+// serbia holds the public holidays of the Law on State and Other Holidays.
+// Orthodox holidays are movable or follow the Julian calendar.
+var serbia = &Country{
+	Code: "RS",
+	Name: "Serbia",
+	Rules: []Rule{
+		{Name: "New Year's Day", Dates: Fixed(time.January, 1), Shift: SundayToNext},
+		{Name: "New Year's Day", Dates: Fixed(time.January, 2), Shift: SundayToNext},
+		{Name: "Orthodox Christmas Day", Dates: Julian(time.December, 25)},
+		{Name: "Statehood Day", Dates: Fixed(time.February, 15), Shift: SundayToNext},
+		{Name: "Statehood Day", Dates: Fixed(time.February, 16), Shift: SundayToNext},
+		{Name: "Orthodox Good Friday", Dates: OrthodoxEasterOffset(-2)},
+		{Name: "Orthodox Holy Saturday", Dates: OrthodoxEasterOffset(-1)},
+		{Name: "Orthodox Easter Sunday", Dates: OrthodoxEasterOffset(0)},
+		{Name: "Orthodox Easter Monday", Dates: OrthodoxEasterOffset(1)},
+		{Name: "Labour Day", Dates: Fixed(time.May, 1), Shift: SundayToNext},
+		{Name: "Labour Day", Dates: Fixed(time.May, 2), Shift: SundayToNext},
+		{Name: "Armistice Day", Dates: Fixed(time.November, 11), Shift: SundayToNext},
+	},
+}
+
+// turkey holds the public holidays of Law No. 2429. The eves of the two
+// feasts are half days, listed as observances.
+var turkey = &Country{
+	Code: "TR",
+	Name: "Türkiye",
+	Rules: []Rule{
+		{Name: "New Year's Day", Dates: Fixed(time.January, 1)},
+		{Name: "National Sovereignty and Children's Day", Dates: Fixed(time.April, 23)},
+		{Name: "Labour and Solidarity Day", Dates: Fixed(time.May, 1)},
+		{Name: "Commemoration of Atatürk, Youth and Sports Day", Dates: Fixed(time.May, 19)},
+		{Name: "Democracy and National Unity Day", Dates: Fixed(time.July, 15), From: 2017},
+		{Name: "Victory Day", Dates: Fixed(time.August, 30)},
+		{Name: "Republic Day", Dates: Fixed(time.October, 29)},
+		{Name: "Ramadan Feast Eve", Type: Observance, Dates: Islamic(9, 30)},
+		{Name: "Ramadan Feast", Dates: Islamic(10, 1)},
+		{Name: "Ramadan Feast Day 2", Dates: Islamic(10, 2)},
+		{Name: "Ramadan Feast Day 3", Dates: Islamic(10, 3)},
+		{Name: "Sacrifice Feast Eve", Type: Observance, Dates: Islamic(12, 9)},
+		{Name: "Sacrifice Feast", Dates: Islamic(12, 10)},
+		{Name: "Sacrifice Feast Day 2", Dates: Islamic(12, 11)},
+		{Name: "Sacrifice Feast Day 3", Dates: Islamic(12, 12)},
+		{Name: "Sacrifice Feast Day 4", Dates: Islamic(12, 13)},
+	},
+}
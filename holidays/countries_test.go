@@ -0,0 +1,93 @@
+package holidays
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestCountries(t *testing.T) {
+	for _, tt := range []struct {
+		code string
+		year int
+		want []string
+	}{
+		{"US", 2023, []string{
+			"2023-01-01 New Year's Day", "2023-01-02 New Year's Day (observed)",
+			"2023-01-16 Birthday of Martin Luther King, Jr.", "2023-02-20 Washington's Birthday",
+			"2023-05-29 Memorial Day", "2023-06-19 Juneteenth National Independence Day",
+			"2023-07-04 Independence Day", "2023-09-04 Labor Day", "2023-10-09 Columbus Day",
+			"2023-11-10 Veterans Day (observed)", "2023-11-11 Veterans Day",
+			"2023-11-23 Thanksgiving Day", "2023-12-25 Christmas Day",
+		}},
+		{"US", 1970, []string{
+			"1970-01-01 New Year's Day", "1970-02-22 Washington's Birthday",
+			"1970-02-23 Washington's Birthday (observed)", "1970-05-29 Memorial Day (observed)",
+			"1970-05-30 Memorial Day", "1970-07-03 Independence Day (observed)", "1970-07-04 Independence Day",
+			"1970-09-07 Labor Day", "1970-11-11 Veterans Day", "1970-11-26 Thanksgiving Day",
+			"1970-12-25 Christmas Day",
+		}},
+		{"US", 1975, []string{
+			"1975-01-01 New Year's Day", "1975-02-17 Washington's Birthday", "1975-05-26 Memorial Day",
+			"1975-07-04 Independence Day", "1975-09-01 Labor Day", "1975-10-13 Columbus Day",
+			"1975-10-27 Veterans Day", "1975-11-27 Thanksgiving Day", "1975-12-25 Christmas Day",
+		}},
+		{"GB-SCT", 2022, []string{
+			"2022-01-01 New Year's Day", "2022-01-02 2nd January", "2022-01-03 New Year's Day (observed)",
+			"2022-01-04 2nd January (observed)", "2022-04-15 Good Friday", "2022-05-02 Early May bank holiday",
+			"2022-05-30 Spring bank holiday", "2022-08-01 Summer bank holiday", "2022-11-30 St Andrew's Day",
+			"2022-12-25 Christmas Day", "2022-12-26 Boxing Day", "2022-12-27 Christmas Day (observed)",
+		}},
+		{"DE-BY", 2024, []string{
+			"2024-01-01 New Year's Day", "2024-01-06 Epiphany", "2024-03-29 Good Friday",
+			"2024-04-01 Easter Monday", "2024-05-01 Labour Day", "2024-05-09 Ascension Day",
+			"2024-05-20 Whit Monday", "2024-05-30 Corpus Christi", "2024-10-03 German Unity Day",
+			"2024-11-01 All Saints' Day", "2024-12-25 Christmas Day", "2024-12-26 St Stephen's Day",
+		}},
+		{"FR-67", 2024, []string{
+			"2024-01-01 New Year's Day", "2024-03-29 Good Friday", "2024-04-01 Easter Monday",
+			"2024-05-01 Labour Day", "2024-05-08 Victory in Europe Day", "2024-05-09 Ascension Day",
+			"2024-05-20 Whit Monday", "2024-07-14 Bastille Day", "2024-08-15 Assumption Day",
+			"2024-11-01 All Saints' Day", "2024-11-11 Armistice Day", "2024-12-25 Christmas Day",
+			"2024-12-26 St Stephen's Day",
+		}},
+		{"HR", 2019, []string{
+			"2019-01-01 New Year's Day", "2019-01-06 Epiphany", "2019-04-21 Easter Sunday",
+			"2019-04-22 Easter Monday", "2019-05-01 Labour Day", "2019-06-20 Corpus Christi",
+			"2019-06-22 Anti-Fascist Struggle Day", "2019-06-25 Statehood Day",
+			"2019-08-05 Victory and Homeland Thanksgiving Day", "2019-08-15 Assumption Day",
+			"2019-10-08 Independence Day", "2019-11-01 All Saints' Day", "2019-12-25 Christmas Day",
+			"2019-12-26 St Stephen's Day",
+		}},
+		{"RS", 2021, []string{
+			"2021-01-01 New Year's Day", "2021-01-02 New Year's Day", "2021-01-07 Orthodox Christmas Day",
+			"2021-02-15 Statehood Day", "2021-02-16 Statehood Day", "2021-04-30 Orthodox Good Friday",
+			"2021-05-01 Orthodox Holy Saturday", "2021-05-01 Labour Day", "2021-05-02 Orthodox Easter Sunday",
+			"2021-05-02 Labour Day", "2021-05-03 Orthodox Easter Monday", "2021-05-04 Labour Day (observed)",
+			"2021-11-11 Armistice Day",
+		}},
+		{"TR", 2024, []string{
+			"2024-01-01 New Year's Day", "2024-04-09 Ramadan Feast Eve", "2024-04-10 Ramadan Feast",
+			"2024-04-11 Ramadan Feast Day 2", "2024-04-12 Ramadan Feast Day 3",
+			"2024-04-23 National Sovereignty and Children's Day", "2024-05-01 Labour and Solidarity Day",
+			"2024-05-19 Commemoration of Atatürk, Youth and Sports Day", "2024-06-16 Sacrifice Feast Eve",
+			"2024-06-17 Sacrifice Feast", "2024-06-18 Sacrifice Feast Day 2", "2024-06-19 Sacrifice Feast Day 3",
+			"2024-06-20 Sacrifice Feast Day 4", "2024-07-15 Democracy and National Unity Day",
+			"2024-08-30 Victory Day", "2024-10-29 Republic Day",
+		}},
+	} {
+		t.Run(fmt.Sprint(tt.code, tt.year), func(t *testing.T) {
+			cal, err := Lookup(tt.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, h := range cal.Holidays(tt.year) {
+				got = append(got, h.Date.String()+" "+h.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) =\n%q\nwant\n%q", tt.year, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Package holidays computes the public holidays of countries and their
+// subdivisions from rules: fixed dates, dates relative to Easter, nth
+// weekdays of a month and dates of the tabular Islamic calendar, along with
+// the days on which holidays falling on a weekend are observed.
+//
+// A Calendar plugs into dt.BusinessCalendar:
+//
+//	cal, err := holidays.Lookup("DE-BY")
+//	if err != nil {
+//		return err
+//	}
+//	bc := dt.BusinessCalendar{Holidays: cal.IsHoliday}
+//
+// Holidays of the Islamic calendar are approximated with its tabular form,
+// so they may differ by a day or two from the dates announced each year.
+package holidays
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// A Type is the kind of a holiday.
+type Type int
+
+const (
+	// Public is a public holiday, a day off for most workers.
+	Public Type = iota
+	// Bank is a bank holiday, on which banks and most businesses close.
+	Bank
+	// Observance is a day that is commemorated, but not a day off.
+	Observance
+)
+
+var typeNames = [...]string{"Public", "Bank", "Observance"}
+
+// String returns the name of t, e.g. "Public".
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("%%!Type(%d)", int(t))
+	}
+	return typeNames[t]
+}
+
+// A Holiday is a holiday falling on a date.
+type Holiday struct {
+	Date dt.Date
+	Name string
+	Type Type
+}
+
+// A Shift tells on which day a holiday falling on a weekend is observed.
+type Shift int
+
+const (
+	// NoShift observes holidays on their date only.
+	NoShift Shift = iota
+	// NearestWeekday observes a holiday falling on a Saturday on the
+	// preceding Friday, and one falling on a Sunday on the following Monday.
+	NearestWeekday
+	// NextWeekday observes a holiday falling on a Saturday or Sunday on the
+	// next weekday that is not already a holiday.
+	NextWeekday
+	// SundayToNext observes a holiday falling on a Sunday on the next
+	// weekday that is not already a holiday.
+	SundayToNext
+)
+
+// A Rule defines a holiday recurring every year.
+type Rule struct {
+	Name  string
+	Type  Type
+	Dates DateFunc
+	Shift Shift
+	// Subdivisions lists the subdivisions observing the holiday, such as "BY"
+	// for Bavaria. If empty, the whole country observes it.
+	Subdivisions []string
+	// From and To are the first and last years the rule applies to, if not zero.
+	From, To int
+}
+
+// applies reports whether r applies in year.
+func (r Rule) applies(year int) bool {
+	return (r.From == 0 || year >= r.From) && (r.To == 0 || year <= r.To)
+}
+
+// A Country holds the holiday rules of a country.
+type Country struct {
+	Code string // ISO 3166-1 alpha-2 code, e.g. "DE".
+	Name string
+	// Subdivisions maps the ISO 3166-2 codes of subdivisions having their
+	// own holidays, without the country prefix, to their names.
+	Subdivisions map[string]string
+	Rules        []Rule
+}
+
+var (
+	countriesMu sync.RWMutex
+	countries   = map[string]*Country{}
+)
+
+// Register adds c to the countries known to Lookup, replacing any country
+// with the same code.
+func Register(c *Country) {
+	countriesMu.Lock()
+	defer countriesMu.Unlock()
+	countries[c.Code] = c
+}
+
+// Countries returns the registered countries, sorted by code.
+func Countries() []*Country {
+	countriesMu.RLock()
+	defer countriesMu.RUnlock()
+	cs := make([]*Country, 0, len(countries))
+	for _, c := range countries {
+		cs = append(cs, c)
+	}
+	slices.SortFunc(cs, func(a, b *Country) int { return cmp.Compare(a.Code, b.Code) })
+	return cs
+}
+
+// Lookup returns the calendar of a country, such as "US", or of one of its
+// subdivisions, such as "DE-BY". The calendar of a country holds the
+// holidays observed in all of it.
+func Lookup(code string) (*Calendar, error) {
+	country, sub, _ := strings.Cut(strings.ToUpper(code), "-")
+	countriesMu.RLock()
+	c, ok := countries[country]
+	countriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("holidays: unknown country %q", code)
+	}
+	if _, ok := c.Subdivisions[sub]; sub != "" && !ok {
+		return nil, fmt.Errorf("holidays: unknown subdivision %q", code)
+	}
+	var rules []Rule
+	for _, r := range c.Rules {
+		if len(r.Subdivisions) == 0 || slices.Contains(r.Subdivisions, sub) {
+			rules = append(rules, r)
+		}
+	}
+	return New(rules...), nil
+}
+
+// A Calendar computes the holidays of a set of rules. Holidays are computed
+// once per year and cached. A Calendar is safe for concurrent use.
+type Calendar struct {
+	rules []Rule
+	mu    sync.Mutex
+	years map[int][]Holiday
+}
+
+// New returns a calendar of the holidays defined by rules, ignoring their
+// Subdivisions.
+func New(rules ...Rule) *Calendar {
+	return &Calendar{rules: rules, years: map[int][]Holiday{}}
+}
+
+// Holidays returns the holidays in year, sorted by date. Holidays observed
+// on another day have an additional entry on that day, named after the
+// holiday followed by " (observed)".
+func (c *Calendar) Holidays(year int) []Holiday {
+	return slices.Clone(c.holidays(year))
+}
+
+// holidays returns the cached holidays in year, computing them if needed.
+// The returned slice must not be modified.
+func (c *Calendar) holidays(year int) []Holiday {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hs, ok := c.years[year]; ok {
+		return hs
+	}
+	// Holidays near the turn of the year may be observed in the next or
+	// previous year.
+	var hs []Holiday
+	for _, h := range c.compute(year-1, year+1) {
+		if h.Date.Year == year {
+			hs = append(hs, h)
+		}
+	}
+	c.years[year] = hs
+	return hs
+}
+
+// On returns the holidays falling on d.
+func (c *Calendar) On(d dt.Date) []Holiday {
+	var hs []Holiday
+	for _, h := range c.holidays(d.Year) {
+		if h.Date == d {
+			hs = append(hs, h)
+		}
+	}
+	return hs
+}
+
+// IsHoliday reports whether d is a public or bank holiday, or the day on
+// which one is observed. It can be used as the Holidays field of a
+// dt.BusinessCalendar.
+func (c *Calendar) IsHoliday(d dt.Date) bool {
+	for _, h := range c.On(d) {
+		if h.Type != Observance {
+			return true
+		}
+	}
+	return false
+}
+
+// compute returns the holidays from first to last year, with the days on
+// which they are observed.
+func (c *Calendar) compute(first, last int) []Holiday {
+	type dated struct {
+		Holiday
+		shift Shift
+	}
+	var all []dated
+	taken := map[dt.Date]bool{}
+	for year := first; year <= last; year++ {
+		for _, r := range c.rules {
+			if !r.applies(year) {
+				continue
+			}
+			for _, d := range r.Dates(year) {
+				all = append(all, dated{Holiday{d, r.Name, r.Type}, r.Shift})
+				if r.Type != Observance {
+					taken[d] = true
+				}
+			}
+		}
+	}
+	slices.SortStableFunc(all, func(a, b dated) int { return a.Date.Compare(b.Date) })
+	hs := make([]Holiday, 0, len(all))
+	for _, h := range all {
+		hs = append(hs, h.Holiday)
+		if h.Type == Observance {
+			continue
+		}
+		if d, ok := observed(h.Date, h.shift, taken); ok {
+			taken[d] = true
+			hs = append(hs, Holiday{d, h.Name + " (observed)", h.Type})
+		}
+	}
+	slices.SortStableFunc(hs, func(a, b Holiday) int { return a.Date.Compare(b.Date) })
+	return hs
+}
+
+// observed returns the day on which a holiday falling on d is observed
+// instead, if any, skipping the days that are taken by other holidays.
+func observed(d dt.Date, s Shift, taken map[dt.Date]bool) (dt.Date, bool) {
+	wd := d.Weekday()
+	switch {
+	case s == NearestWeekday && wd == time.Saturday:
+		return d.AddDays(-1), true
+	case s == NearestWeekday && wd == time.Sunday:
+		return d.AddDays(1), true
+	case s == NextWeekday && (wd == time.Saturday || wd == time.Sunday),
+		s == SundayToNext && wd == time.Sunday:
+		for {
+			d = d.AddDays(1)
+			if wd := d.Weekday(); wd != time.Saturday && wd != time.Sunday && !taken[d] {
+				return d, true
+			}
+		}
+	}
+	return dt.Date{}, false
+}
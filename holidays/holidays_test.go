@@ -0,0 +1,193 @@
+package holidays
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func date(y int, m time.Month, d int) dt.Date {
+	return dt.Date{Year: y, Month: m, Day: d, Valid: true}
+}
+
+func TestObserved(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		rules []Rule
+		year  int
+		want  []Holiday
+	}{
+		{
+			"Nearest weekday before",
+			[]Rule{{Name: "Independence Day", Dates: Fixed(time.July, 4), Shift: NearestWeekday}},
+			2026,
+			[]Holiday{{date(2026, 7, 3), "Independence Day (observed)", Public}, {date(2026, 7, 4), "Independence Day", Public}},
+		},
+		{
+			"Nearest weekday after",
+			[]Rule{{Name: "Independence Day", Dates: Fixed(time.July, 4), Shift: NearestWeekday}},
+			2027,
+			[]Holiday{{date(2027, 7, 4), "Independence Day", Public}, {date(2027, 7, 5), "Independence Day (observed)", Public}},
+		},
+		{
+			"Observed in the previous year",
+			[]Rule{{Name: "New Year's Day", Dates: Fixed(time.January, 1), Shift: NearestWeekday}},
+			2021,
+			[]Holiday{{date(2021, 1, 1), "New Year's Day", Public}, {date(2021, 12, 31), "New Year's Day (observed)", Public}},
+		},
+		{
+			"Next weekday skips holidays",
+			[]Rule{
+				{Name: "Christmas Day", Type: Bank, Dates: Fixed(time.December, 25), Shift: NextWeekday},
+				{Name: "Boxing Day", Type: Bank, Dates: Fixed(time.December, 26), Shift: NextWeekday},
+			},
+			2022,
+			[]Holiday{
+				{date(2022, 12, 25), "Christmas Day", Bank},
+				{date(2022, 12, 26), "Boxing Day", Bank},
+				{date(2022, 12, 27), "Christmas Day (observed)", Bank},
+			},
+		},
+		{
+			"Sunday to next leaves Saturday",
+			[]Rule{
+				{Name: "Labour Day", Dates: Fixed(time.May, 1), Shift: SundayToNext},
+				{Name: "Labour Day", Dates: Fixed(time.May, 2), Shift: SundayToNext},
+			},
+			2021,
+			[]Holiday{
+				{date(2021, 5, 1), "Labour Day", Public},
+				{date(2021, 5, 2), "Labour Day", Public},
+				{date(2021, 5, 3), "Labour Day (observed)", Public},
+			},
+		},
+		{
+			"Observances are not shifted",
+			[]Rule{{Name: "Eve", Type: Observance, Dates: Fixed(time.May, 2), Shift: SundayToNext}},
+			2021,
+			[]Holiday{{date(2021, 5, 2), "Eve", Observance}},
+		},
+		{
+			"Outside of years",
+			[]Rule{{Name: "Juneteenth", Dates: Fixed(time.June, 19), From: 2021, To: 2030}},
+			2020,
+			nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.rules...).Holidays(tt.year)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Holidays(%d)[%d] = %v, want %v", tt.year, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	cal := New(
+		Rule{Name: "Christmas Day", Dates: Fixed(time.December, 25), Shift: NearestWeekday},
+		Rule{Name: "Christmas Eve", Type: Observance, Dates: Fixed(time.December, 24)},
+	)
+	for _, tt := range []struct {
+		d    dt.Date
+		want bool
+	}{
+		{date(2027, 12, 25), true},
+		{date(2027, 12, 24), true},
+		{date(2024, 12, 24), false},
+		{date(2024, 12, 26), false},
+	} {
+		if got := cal.IsHoliday(tt.d); got != tt.want {
+			t.Errorf("IsHoliday(%v) = %t, want %t", tt.d, got, tt.want)
+		}
+	}
+	if got := cal.On(date(2027, 12, 24)); len(got) != 2 {
+		t.Errorf("On(2027-12-24) = %v, want Christmas Eve and observed Christmas Day", got)
+	}
+
+	bc := dt.BusinessCalendar{Holidays: cal.IsHoliday}
+	if got, want := bc.AddBusinessDays(date(2027, 12, 23), 1), date(2027, 12, 27); got != want {
+		t.Errorf("AddBusinessDays = %v, want %v", got, want)
+	}
+}
+
+func TestHolidaysCopy(t *testing.T) {
+	cal := New(Rule{Name: "Christmas Day", Dates: Fixed(time.December, 25)})
+	hs := cal.Holidays(2024)
+	hs[0].Name = "changed"
+	_ = append(hs[:0], Holiday{Name: "appended"})
+	if got := cal.Holidays(2024); len(got) != 1 || got[0].Name != "Christmas Day" {
+		t.Errorf("Holidays(2024) = %v after modifying an earlier result", got)
+	}
+	if !cal.IsHoliday(date(2024, 12, 25)) {
+		t.Error("IsHoliday(2024-12-25) = false after modifying an earlier result")
+	}
+}
+
+func TestCalendarConcurrency(t *testing.T) {
+	cal, err := Lookup("DE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for y := 2000; y < 2050; y++ {
+				cal.IsHoliday(date(y, time.Month(i+1), 1))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLookup(t *testing.T) {
+	for _, tt := range []struct {
+		code string
+		err  bool
+	}{
+		{"US", false},
+		{"de-by", false},
+		{"GB-SCT", false},
+		{"XX", true},
+		{"DE-XX", true},
+	} {
+		t.Run(tt.code, func(t *testing.T) {
+			if _, err := Lookup(tt.code); (err != nil) != tt.err {
+				t.Errorf("Lookup(%q) error = %v, want error %t", tt.code, err, tt.err)
+			}
+		})
+	}
+
+	var codes []string
+	for _, c := range Countries() {
+		codes = append(codes, c.Code)
+	}
+	if got, want := len(codes), 7; got != want || codes[0] != "DE" {
+		t.Errorf("Countries() = %v", codes)
+	}
+}
+
+func TestTypeString(t *testing.T) {
+	for _, tt := range []struct {
+		t    Type
+		want string
+	}{
+		{Public, "Public"},
+		{Bank, "Bank"},
+		{Observance, "Observance"},
+		{Type(7), "%!Type(7)"},
+	} {
+		if got := tt.t.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
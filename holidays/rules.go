@@ -0,0 +1,90 @@
+package holidays
+
+import (
+	"time"
+
+	"github.com/ribice/dt"
+	"github.com/ribice/dt/calendar"
+)
+
+// A DateFunc returns the dates of a holiday in a Gregorian year.
+// Most holidays fall once a year, but holidays of other calendars may fall
+// twice or not at all.
+type DateFunc func(year int) []dt.Date
+
+// Fixed returns the DateFunc of a holiday falling on the same day every year.
+// A holiday on February 29th only falls in leap years.
+func Fixed(month time.Month, day int) DateFunc {
+	return func(year int) []dt.Date {
+		if day > dt.DaysInMonth(year, month) {
+			return nil
+		}
+		return []dt.Date{{Year: year, Month: month, Day: day, Valid: true}}
+	}
+}
+
+// NthWeekday returns the DateFunc of a holiday falling on the n-th weekday
+// wd of month. Negative n counts from the end of the month, so -1 is the last.
+func NthWeekday(month time.Month, n int, wd time.Weekday) DateFunc {
+	return func(year int) []dt.Date {
+		d := dt.Date{Year: year, Month: month, Day: 1, Valid: true}.NthWeekdayOfMonth(n, wd)
+		if !d.Valid {
+			return nil
+		}
+		return []dt.Date{d}
+	}
+}
+
+// WeekdayBefore returns the DateFunc of a holiday falling on the last
+// weekday wd before the given day of month, such as the Wednesday before
+// November 23rd.
+func WeekdayBefore(month time.Month, day int, wd time.Weekday) DateFunc {
+	return func(year int) []dt.Date {
+		return []dt.Date{dt.Date{Year: year, Month: month, Day: day, Valid: true}.PreviousWeekday(wd)}
+	}
+}
+
+// EasterOffset returns the DateFunc of a holiday falling days after Western
+// Easter Sunday, or before it if days is negative.
+func EasterOffset(days int) DateFunc {
+	return func(year int) []dt.Date {
+		return []dt.Date{dt.Easter(year).AddDays(days)}
+	}
+}
+
+// OrthodoxEasterOffset returns the DateFunc of a holiday falling days after
+// Orthodox Easter Sunday, or before it if days is negative.
+func OrthodoxEasterOffset(days int) DateFunc {
+	return func(year int) []dt.Date {
+		return []dt.Date{dt.OrthodoxEaster(year).AddDays(days)}
+	}
+}
+
+// Julian returns the DateFunc of a holiday falling on a day of the Julian
+// calendar, such as Orthodox Christmas on December 25th.
+func Julian(month time.Month, day int) DateFunc {
+	return inCalendar(calendar.Julian{}, int(month), day)
+}
+
+// Islamic returns the DateFunc of a holiday falling on a day of the tabular
+// Islamic calendar, such as Eid al-Fitr on 1 Shawwal. Such holidays fall
+// about 11 days earlier every year, and twice in some years.
+func Islamic(month, day int) DateFunc {
+	return inCalendar(calendar.Islamic{}, month, day)
+}
+
+// inCalendar returns the DateFunc of a holiday falling on the given month and
+// day of c, whose years must not be longer than Gregorian ones.
+func inCalendar(c calendar.Calendar, month, day int) DateFunc {
+	return func(year int) []dt.Date {
+		first, _, _ := c.FromDate(dt.Date{Year: year, Month: time.January, Day: 1, Valid: true})
+		last, _, _ := c.FromDate(dt.Date{Year: year, Month: time.December, Day: 31, Valid: true})
+		var ds []dt.Date
+		for y := first; y <= last; y++ {
+			if d, err := c.ToDate(y, month, day); err == nil && d.Year == year {
+				ds = append(ds, d)
+			}
+		}
+		return ds
+	}
+}
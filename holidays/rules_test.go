@@ -0,0 +1,42 @@
+package holidays
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func TestDateFuncs(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		f     DateFunc
+		year  int
+		dates []dt.Date
+	}{
+		{"Fixed", Fixed(time.July, 14), 2024, []dt.Date{date(2024, 7, 14)}},
+		{"Fixed leap day", Fixed(time.February, 29), 2023, nil},
+		{"Third Monday", NthWeekday(time.January, 3, time.Monday), 2024, []dt.Date{date(2024, 1, 15)}},
+		{"Last Monday", NthWeekday(time.May, -1, time.Monday), 2024, []dt.Date{date(2024, 5, 27)}},
+		{"Fifth Monday", NthWeekday(time.February, 5, time.Monday), 2024, nil},
+		{"Wednesday before", WeekdayBefore(time.November, 23, time.Wednesday), 2022, []dt.Date{date(2022, 11, 16)}},
+		{"Good Friday", EasterOffset(-2), 2024, []dt.Date{date(2024, 3, 29)}},
+		{"Orthodox Easter Monday", OrthodoxEasterOffset(1), 2024, []dt.Date{date(2024, 5, 6)}},
+		{"Orthodox Christmas", Julian(time.December, 25), 2024, []dt.Date{date(2024, 1, 7)}},
+		{"Orthodox Christmas after 2100", Julian(time.December, 25), 2101, []dt.Date{date(2101, 1, 8)}},
+		{"Eid al-Fitr", Islamic(10, 1), 2024, []dt.Date{date(2024, 4, 10)}},
+		{"Eid al-Fitr twice", Islamic(10, 1), 2000, []dt.Date{date(2000, 1, 8), date(2000, 12, 28)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f(tt.year)
+			if len(got) != len(tt.dates) {
+				t.Fatalf("got %v, want %v", got, tt.dates)
+			}
+			for i := range got {
+				if got[i] != tt.dates[i] {
+					t.Errorf("got %v, want %v", got, tt.dates)
+				}
+			}
+		})
+	}
+}
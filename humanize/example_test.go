@@ -0,0 +1,41 @@
+package humanize_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ribice/dt"
+	"github.com/ribice/dt/humanize"
+)
+
+func Example() {
+	due := dt.Today(time.Local).AddDays(3)
+	start := dt.Now(time.Local)
+	start.Date = start.Date.AddDays(-1)
+	fmt.Println(humanize.Date(due, dt.Today(time.Local)))
+	fmt.Println(humanize.DateTime(start, dt.Now(time.Local)))
+}
+
+func ExampleDate() {
+	ref := dt.Date{Year: 2024, Month: time.May, Day: 15, Valid: true}
+	fmt.Println(humanize.Date(ref.AddDays(1), ref))
+	fmt.Println(humanize.Date(ref.AddDays(3), ref))
+	fmt.Println(humanize.Date(ref.AddDays(-20), ref))
+	// Output:
+	// tomorrow
+	// next Saturday
+	// 3 weeks ago
+}
+
+func ExampleDateTime() {
+	ref := dt.DateTime{
+		Date: dt.Date{Year: 2024, Month: time.May, Day: 15, Valid: true},
+		Time: dt.Time{Hour: 16, Minute: 0, Valid: true},
+	}
+	start := dt.DateTime{Date: ref.Date.AddDays(-1), Time: dt.Time{Hour: 14, Minute: 0, Valid: true}}
+	fmt.Println(humanize.DateTime(start, ref))
+	fmt.Println(humanize.DateTime(dt.DateTime{Date: ref.Date, Time: dt.Time{Hour: 15, Minute: 55, Valid: true}}, ref))
+	// Output:
+	// yesterday at 14:00
+	// 5 minutes ago
+}
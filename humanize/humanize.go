@@ -0,0 +1,160 @@
+// Package humanize formats dt.Date and dt.DateTime values relative to a
+// reference value, producing strings such as "tomorrow", "last Monday",
+// "in 2 weeks" and "5 minutes ago":
+//
+//	humanize.Date(due, dt.Today(time.Local))      // "in 3 days"
+//	humanize.DateTime(start, dt.Now(time.Local))  // "yesterday at 14:00"
+//
+// The wording comes from a Locale, and the points at which one unit gives
+// way to the next are set by Thresholds. English and German are built in,
+// and further locales can be added with Register.
+package humanize
+
+import (
+	"fmt"
+
+	"github.com/ribice/dt"
+)
+
+// Thresholds control which unit describes a difference. Each field is the
+// number of units below which that unit is used; larger differences move on
+// to the next unit.
+type Thresholds struct {
+	// Minute applies to DateTime differences of less than an hour.
+	Minute int
+	// Hour applies to DateTime differences of less than a day.
+	Hour int
+	// Weekday names the day of the week, as in "next Monday", for differences
+	// of fewer than Weekday days. Zero disables weekday names.
+	Weekday int
+	// Day gives differences of fewer than Day days in days, as in "in 3 days".
+	Day int
+	// Week gives differences of fewer than Week weeks, rounded to the nearest
+	// week, in weeks.
+	Week int
+	// Month gives differences of fewer than Month whole months in months.
+	// Larger differences are given in years.
+	Month int
+}
+
+// DefaultThresholds are the thresholds used by New.
+var DefaultThresholds = Thresholds{
+	Minute:  60,
+	Hour:    24,
+	Weekday: 7,
+	Day:     7,
+	Week:    4,
+	Month:   12,
+}
+
+// A Humanizer formats dates relative to a reference in a Locale.
+type Humanizer struct {
+	Locale     *Locale
+	Thresholds Thresholds
+}
+
+// New returns a Humanizer for l with DefaultThresholds.
+func New(l *Locale) *Humanizer {
+	return &Humanizer{Locale: l, Thresholds: DefaultThresholds}
+}
+
+// Date describes d relative to ref in English, using DefaultThresholds.
+func Date(d, ref dt.Date) string {
+	return New(English).Date(d, ref)
+}
+
+// DateTime describes d relative to ref in English, using DefaultThresholds.
+func DateTime(d, ref dt.DateTime) string {
+	return New(English).DateTime(d, ref)
+}
+
+// Date describes d relative to ref, for example "today", "next Friday" or
+// "3 months ago". It returns an empty string if either date is not Valid.
+func (h *Humanizer) Date(d, ref dt.Date) string {
+	if !d.Valid || !ref.Valid {
+		return ""
+	}
+	s, _ := h.date(d, ref)
+	return s
+}
+
+// DateTime describes d relative to ref. Differences of less than a day are
+// given in minutes or hours, as in "in 20 minutes"; larger ones are described
+// by their date, adding the time of day when the date is named, as in
+// "tomorrow at 09:00". It returns an empty string if either value is not Valid.
+func (h *Humanizer) DateTime(d, ref dt.DateTime) string {
+	if !d.Date.Valid || !d.Time.Valid || !ref.Date.Valid || !ref.Time.Valid {
+		return ""
+	}
+	mins := minutes(d) - minutes(ref)
+	switch abs := absInt(mins); {
+	case abs == 0:
+		return h.Locale.Now
+	case abs < h.Thresholds.Minute:
+		return h.relative(Minute, mins)
+	case abs/60 < h.Thresholds.Hour:
+		return h.relative(Hour, mins/60)
+	}
+	s, named := h.date(d.Date, ref.Date)
+	if named {
+		s = fmt.Sprintf(h.Locale.At, s, d.Time)
+	}
+	return s
+}
+
+// date describes d relative to ref, and reports whether the description names
+// a single day rather than a number of units.
+func (h *Humanizer) date(d, ref dt.Date) (string, bool) {
+	l, t := h.Locale, h.Thresholds
+	days := d.UnixDays() - ref.UnixDays()
+	abs := absInt(days)
+	switch {
+	case days == 0:
+		return l.Today, true
+	case days == 1:
+		return l.Tomorrow, true
+	case days == -1:
+		return l.Yesterday, true
+	case abs < t.Weekday:
+		name := l.Weekdays[d.Weekday()]
+		if days > 0 {
+			return fmt.Sprintf(l.Next, name), true
+		}
+		return fmt.Sprintf(l.Last, name), true
+	case abs < t.Day:
+		return h.relative(Day, days), false
+	case (abs+3)/7 < t.Week:
+		return h.relative(Week, sign(days)*((abs+3)/7)), false
+	}
+	months := max(absInt(d.MonthsSince(ref)), 1)
+	if months < t.Month {
+		return h.relative(Month, sign(days)*months), false
+	}
+	return h.relative(Year, sign(days)*max(months/12, 1)), false
+}
+
+// relative returns n units in the future or past, as in "in 2 weeks".
+func (h *Humanizer) relative(u Unit, n int) string {
+	if n < 0 {
+		return fmt.Sprintf(h.Locale.Past, h.Locale.quantity(u, -n))
+	}
+	return fmt.Sprintf(h.Locale.Future, h.Locale.quantity(u, n))
+}
+
+func minutes(d dt.DateTime) int {
+	return d.Date.UnixDays()*24*60 + d.Time.Hour*60 + d.Time.Minute
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func sign(n int) int {
+	if n < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,94 @@
+package humanize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// ref is Wednesday, 15 May 2024.
+var ref = dt.Date{Year: 2024, Month: time.May, Day: 15, Valid: true}
+
+func TestDate(t *testing.T) {
+	for _, tt := range []struct {
+		days int
+		want string
+	}{
+		{0, "today"},
+		{1, "tomorrow"},
+		{-1, "yesterday"},
+		{2, "next Friday"},
+		{-6, "last Thursday"},
+		{7, "in 1 week"},
+		{-10, "1 week ago"},
+		{11, "in 2 weeks"},
+		{27, "in 1 month"},
+		{-45, "1 month ago"},
+		{200, "in 6 months"},
+		{365, "in 1 year"},
+		{-1000, "2 years ago"},
+	} {
+		d := ref.AddDays(tt.days)
+		if got := Date(d, ref); got != tt.want {
+			t.Errorf("Date(%v, %v): expected %q, got %q", d, ref, tt.want, got)
+		}
+	}
+	if got := Date(dt.Date{}, ref); got != "" {
+		t.Errorf("expected empty string for invalid date, got %q", got)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	now := dt.DateTime{Date: ref, Time: dt.Time{Hour: 12, Valid: true}}
+	at := func(days, hour, minute int) dt.DateTime {
+		return dt.DateTime{Date: ref.AddDays(days), Time: dt.Time{Hour: hour, Minute: minute, Valid: true}}
+	}
+	for _, tt := range []struct {
+		name string
+		d    dt.DateTime
+		want string
+	}{
+		{"Now", now, "now"},
+		{"Minute ahead", at(0, 12, 1), "in 1 minute"},
+		{"Minutes ago", at(0, 11, 5), "55 minutes ago"},
+		{"Hours ahead", at(0, 15, 30), "in 3 hours"},
+		{"Hours ago across midnight", at(-1, 14, 0), "22 hours ago"},
+		{"Yesterday", at(-1, 9, 0), "yesterday at 09:00"},
+		{"Next weekday", at(3, 8, 15), "next Saturday at 08:15"},
+		{"Weeks ahead", at(14, 8, 15), "in 2 weeks"},
+		{"Invalid time", dt.DateTime{Date: ref}, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DateTime(tt.d, now); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestThresholds(t *testing.T) {
+	h := New(English)
+	h.Thresholds.Weekday = 0
+	h.Thresholds.Day = 14
+	h.Thresholds.Hour = 12
+	for _, tt := range []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"Weekday names disabled", h.Date(ref.AddDays(3), ref), "in 3 days"},
+		{"Days up to threshold", h.Date(ref.AddDays(-13), ref), "13 days ago"},
+		{"Adjacent days still named", h.Date(ref.AddDays(1), ref), "tomorrow"},
+		{"Hours beyond threshold", h.DateTime(
+			dt.DateTime{Date: ref, Time: dt.Time{Hour: 23, Valid: true}},
+			dt.DateTime{Date: ref, Time: dt.Time{Hour: 9, Valid: true}},
+		), "today at 23:00"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, tt.got)
+			}
+		})
+	}
+}
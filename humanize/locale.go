@@ -0,0 +1,138 @@
+package humanize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A Unit is a unit of relative time.
+type Unit int
+
+// Units used in relative descriptions.
+const (
+	Minute Unit = iota
+	Hour
+	Day
+	Week
+	Month
+	Year
+	numUnits
+)
+
+// A Locale holds the wording used by a Humanizer.
+type Locale struct {
+	// Now describes a DateTime equal to the reference.
+	Now string
+	// Today, Tomorrow and Yesterday describe adjacent dates.
+	Today, Tomorrow, Yesterday string
+	// Future and Past wrap a quantity such as "3 days" into "in %s" or "%s ago".
+	Future, Past string
+	// Next and Last wrap a weekday name, as in "next %s" and "last %s".
+	Next, Last string
+	// At joins a named date and a time of day, as in "%s at %s".
+	At string
+	// Weekdays are the weekday names, indexed by time.Weekday.
+	Weekdays [7]string
+	// Units holds the plural forms of each unit as format strings for the
+	// count, such as {"%d day", "%d days"}, indexed by Unit. A unit without
+	// forms is written as a bare count.
+	Units [numUnits][]string
+	// Plural returns the index into the forms of a unit to use for n.
+	// If nil, the first form is used for 1 and the second for any other n.
+	// Indexes outside the forms are clamped to the first or last form.
+	Plural func(n int) int
+}
+
+// quantity returns n of unit u, as in "3 days".
+func (l *Locale) quantity(u Unit, n int) string {
+	forms := l.Units[u]
+	i := 0
+	if l.Plural != nil {
+		i = l.Plural(n)
+	} else if n != 1 {
+		i = 1
+	}
+	if len(forms) == 0 {
+		return strconv.Itoa(n)
+	}
+	return fmt.Sprintf(forms[min(max(i, 0), len(forms)-1)], n)
+}
+
+// English is the built-in English locale.
+var English = &Locale{
+	Now:       "now",
+	Today:     "today",
+	Tomorrow:  "tomorrow",
+	Yesterday: "yesterday",
+	Future:    "in %s",
+	Past:      "%s ago",
+	Next:      "next %s",
+	Last:      "last %s",
+	At:        "%s at %s",
+	Weekdays:  [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	Units: [numUnits][]string{
+		Minute: {"%d minute", "%d minutes"},
+		Hour:   {"%d hour", "%d hours"},
+		Day:    {"%d day", "%d days"},
+		Week:   {"%d week", "%d weeks"},
+		Month:  {"%d month", "%d months"},
+		Year:   {"%d year", "%d years"},
+	},
+}
+
+// German is the built-in German locale.
+var German = &Locale{
+	Now:       "jetzt",
+	Today:     "heute",
+	Tomorrow:  "morgen",
+	Yesterday: "gestern",
+	Future:    "in %s",
+	Past:      "vor %s",
+	Next:      "nächsten %s",
+	Last:      "letzten %s",
+	At:        "%s um %s",
+	Weekdays:  [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	Units: [numUnits][]string{
+		Minute: {"%d Minute", "%d Minuten"},
+		Hour:   {"%d Stunde", "%d Stunden"},
+		Day:    {"%d Tag", "%d Tagen"},
+		Week:   {"%d Woche", "%d Wochen"},
+		Month:  {"%d Monat", "%d Monaten"},
+		Year:   {"%d Jahr", "%d Jahren"},
+	},
+}
+
+var (
+	mu      sync.RWMutex
+	locales = map[string]*Locale{
+		"en": English,
+		"de": German,
+	}
+)
+
+// Register makes l available to Lookup under the language tag.
+// It replaces any locale previously registered under the same tag.
+func Register(tag string, l *Locale) {
+	mu.Lock()
+	defer mu.Unlock()
+	locales[strings.ToLower(tag)] = l
+}
+
+// Lookup returns the locale registered under the language tag, such as "en"
+// or "de-AT". If no locale is registered under tag, it falls back to the
+// primary language, so "de-AT" finds "de".
+func Lookup(tag string) (*Locale, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	if l, ok := locales[tag]; ok {
+		return l, true
+	}
+	if i := strings.IndexByte(tag, '-'); i > 0 {
+		l, ok := locales[tag[:i]]
+		return l, ok
+	}
+	return nil, false
+}
@@ -0,0 +1,112 @@
+package humanize
+
+import (
+	"testing"
+
+	"github.com/ribice/dt"
+)
+
+func TestGerman(t *testing.T) {
+	h := New(German)
+	now := dt.DateTime{Date: ref, Time: dt.Time{Hour: 12, Valid: true}}
+	for _, tt := range []struct {
+		got  string
+		want string
+	}{
+		{h.Date(ref.AddDays(5), ref), "nächsten Montag"},
+		{h.Date(ref.AddDays(-21), ref), "vor 3 Wochen"},
+		{h.Date(ref.AddDays(400), ref), "in 1 Jahr"},
+		{h.DateTime(dt.DateTime{Date: ref.AddDays(1), Time: dt.Time{Hour: 14, Valid: true}}, now), "morgen um 14:00"},
+		{h.DateTime(dt.DateTime{Date: ref, Time: dt.Time{Hour: 11, Minute: 59, Valid: true}}, now), "vor 1 Minute"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, tt.got)
+		}
+	}
+}
+
+func TestPlural(t *testing.T) {
+	// Croatian has separate forms for numbers ending in 2-4 and for other numbers.
+	hr := &Locale{
+		Future: "za %s",
+		Past:   "prije %s",
+		Units: [numUnits][]string{
+			Day:  {"%d dan", "%d dana", "%d dana"},
+			Week: {"%d tjedan", "%d tjedna", "%d tjedana"},
+		},
+		Plural: func(n int) int {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return 0
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return 1
+			}
+			return 2
+		},
+	}
+	h := New(hr)
+	h.Thresholds.Weekday = 0
+	h.Thresholds.Week = 10
+	for _, tt := range []struct {
+		days int
+		want string
+	}{
+		{-5, "prije 5 dana"},
+		{7, "za 1 tjedan"},
+		{21, "za 3 tjedna"},
+		{-35, "prije 5 tjedana"},
+	} {
+		if got := h.Date(ref.AddDays(tt.days), ref); got != tt.want {
+			t.Errorf("%d days: expected %q, got %q", tt.days, tt.want, got)
+		}
+	}
+}
+
+func TestMissingForms(t *testing.T) {
+	l := &Locale{
+		Future: "in %s",
+		Units: [numUnits][]string{
+			Day: {"%d day", "%d days"},
+		},
+		Plural: func(n int) int { return n - 5 },
+	}
+	for _, tt := range []struct {
+		u    Unit
+		n    int
+		want string
+	}{
+		{Day, 3, "3 day"},
+		{Day, 7, "7 days"},
+		{Day, 9, "9 days"},
+		{Month, 2, "2"},
+	} {
+		if got := l.quantity(tt.u, tt.n); got != tt.want {
+			t.Errorf("quantity(%d, %d): expected %q, got %q", tt.u, tt.n, tt.want, got)
+		}
+	}
+	if got, want := New(l).Date(ref.AddDays(62), ref), "in 2"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	custom := &Locale{Today: "i dag"}
+	Register("nb", custom)
+	for _, tt := range []struct {
+		tag  string
+		want *Locale
+	}{
+		{"en", English},
+		{"de_AT", German},
+		{"DE-ch", German},
+		{"nb-NO", custom},
+		{"fr", nil},
+	} {
+		if got, ok := Lookup(tt.tag); got != tt.want || ok != (tt.want != nil) {
+			t.Errorf("Lookup(%q): expected %p, got %p", tt.tag, tt.want, got)
+		}
+	}
+	if got := New(custom).Date(ref, ref); got != "i dag" {
+		t.Errorf("expected %q, got %q", "i dag", got)
+	}
+}
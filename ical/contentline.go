@@ -0,0 +1,261 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Property is a content line of a component, such as
+// DTSTART;TZID=Europe/Berlin:20240515T090000.
+type Property struct {
+	Name   string // Upper-case name, e.g. "DTSTART".
+	Params []Param
+	Value  string // Raw value, with text escapes left in place.
+}
+
+// A Param is a property parameter, such as TZID=Europe/Berlin.
+type Param struct {
+	Name   string // Upper-case name, e.g. "TZID".
+	Values []string
+}
+
+// Param returns the first value of the parameter name, or an empty string.
+func (p Property) Param(name string) string {
+	for _, param := range p.Params {
+		if param.Name == name && len(param.Values) > 0 {
+			return param.Values[0]
+		}
+	}
+	return ""
+}
+
+// A Component is a component that is not otherwise handled by this package,
+// such as a VALARM or VJOURNAL, kept so that it can be written back.
+type Component struct {
+	Name       string // Upper-case name, e.g. "VALARM".
+	Props      []Property
+	Components []Component
+	line       int // Line of the BEGIN property.
+}
+
+// An Error reports a syntax error in an iCalendar stream.
+type Error struct {
+	Line int // Line on which the content line starts, counting from 1.
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("ical: line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// readComponents reads the content lines of r into a tree of components.
+func readComponents(r io.Reader) ([]Component, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	var (
+		roots   []Component
+		stack   []*Component
+		line    strings.Builder
+		lineNum int
+		started bool
+	)
+	flush := func() error {
+		if !started {
+			return nil
+		}
+		p, err := parseLine(line.String())
+		line.Reset()
+		if err != nil {
+			return &Error{lineNum, err}
+		}
+		switch p.Name {
+		case "BEGIN":
+			c := Component{Name: strings.ToUpper(p.Value), line: lineNum}
+			if len(stack) == 0 {
+				roots = append(roots, c)
+				stack = append(stack, &roots[len(roots)-1])
+			} else {
+				top := stack[len(stack)-1]
+				top.Components = append(top.Components, c)
+				stack = append(stack, &top.Components[len(top.Components)-1])
+			}
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(p.Value) {
+				return &Error{lineNum, fmt.Errorf("unexpected END:%s", p.Value)}
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return &Error{lineNum, fmt.Errorf("property %s outside of a component", p.Name)}
+			}
+			top := stack[len(stack)-1]
+			top.Props = append(top.Props, p)
+		}
+		return nil
+	}
+	for n := 1; sc.Scan(); n++ {
+		s := strings.TrimSuffix(sc.Text(), "\r")
+		if s != "" && (s[0] == ' ' || s[0] == '\t') && started {
+			line.WriteString(s[1:])
+			continue
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		started = s != ""
+		line.WriteString(s)
+		lineNum = n
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if len(stack) > 0 {
+		return nil, &Error{lineNum, fmt.Errorf("missing END:%s", stack[len(stack)-1].Name)}
+	}
+	return roots, nil
+}
+
+// parseLine parses an unfolded content line.
+func parseLine(s string) (Property, error) {
+	var p Property
+	i := strings.IndexAny(s, ";:")
+	if i <= 0 {
+		return p, errors.New("missing property name")
+	}
+	p.Name = strings.ToUpper(s[:i])
+	missing := fmt.Errorf("missing value of %s", p.Name)
+	rest := s[i:]
+	for rest[0] == ';' {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return p, fmt.Errorf("invalid parameter in %s", p.Name)
+		}
+		param := Param{Name: strings.ToUpper(rest[:eq])}
+		rest = rest[eq+1:]
+		for {
+			var v string
+			if strings.HasPrefix(rest, `"`) {
+				end := strings.IndexByte(rest[1:], '"')
+				if end < 0 {
+					return p, fmt.Errorf("unterminated quote in %s", p.Name)
+				}
+				v, rest = rest[1:end+1], rest[end+2:]
+			} else {
+				end := strings.IndexAny(rest, ",;:")
+				if end < 0 {
+					return p, missing
+				}
+				v, rest = rest[:end], rest[end:]
+			}
+			param.Values = append(param.Values, v)
+			if rest == "" {
+				return p, missing
+			}
+			if rest[0] != ',' {
+				break
+			}
+			rest = rest[1:]
+		}
+		p.Params = append(p.Params, param)
+	}
+	if rest[0] != ':' {
+		return p, missing
+	}
+	p.Value = rest[1:]
+	return p, nil
+}
+
+var (
+	unescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+	escaper   = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`, "\r", "")
+)
+
+// unescape returns the text value s with its escapes resolved.
+func unescape(s string) string {
+	return unescaper.Replace(s)
+}
+
+// escape returns the text s as a TEXT value.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// A writer writes folded content lines, keeping the first error.
+type writer struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+// maxLineLength is the number of octets after which lines are folded.
+const maxLineLength = 75
+
+// prop writes a content line.
+func (w *writer) prop(name string, params []Param, value string) {
+	var b strings.Builder
+	b.WriteString(name)
+	for _, p := range params {
+		b.WriteString(";" + p.Name + "=")
+		for i, v := range p.Values {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if strings.ContainsAny(v, ",;:") {
+				v = `"` + v + `"`
+			}
+			b.WriteString(v)
+		}
+	}
+	b.WriteString(":" + value)
+	w.fold(b.String())
+}
+
+// fold writes s, folded into lines of at most maxLineLength octets without
+// splitting UTF-8 sequences.
+func (w *writer) fold(s string) {
+	limit := maxLineLength
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		w.write(s[:i] + "\r\n ")
+		s = s[i:]
+		limit = maxLineLength - 1
+	}
+	w.write(s + "\r\n")
+}
+
+func (w *writer) write(s string) {
+	if w.err != nil {
+		return
+	}
+	n, err := io.WriteString(w.w, s)
+	w.n += int64(n)
+	w.err = err
+}
+
+// component writes c and its subcomponents.
+func (w *writer) component(c Component) {
+	w.prop("BEGIN", nil, c.Name)
+	for _, p := range c.Props {
+		w.prop(p.Name, p.Params, p.Value)
+	}
+	for _, sub := range c.Components {
+		w.component(sub)
+	}
+	w.prop("END", nil, c.Name)
+}
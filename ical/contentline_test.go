@@ -0,0 +1,79 @@
+package ical
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseLine(t *testing.T) {
+	for _, tt := range []struct {
+		line    string
+		want    Property
+		wantErr bool
+	}{
+		{line: "SUMMARY:Lunch", want: Property{Name: "SUMMARY", Value: "Lunch"}},
+		{line: "summary:a:b", want: Property{Name: "SUMMARY", Value: "a:b"}},
+		{line: "DTSTART;TZID=Europe/Berlin:20240506T093000", want: Property{Name: "DTSTART", Params: []Param{{"TZID", []string{"Europe/Berlin"}}}, Value: "20240506T093000"}},
+		{
+			line: `ATTENDEE;CN="Doe, Jane";member="mailto:a@x","mailto:b@x":mailto:j@x`,
+			want: Property{Name: "ATTENDEE", Params: []Param{{"CN", []string{"Doe, Jane"}}, {"MEMBER", []string{"mailto:a@x", "mailto:b@x"}}}, Value: "mailto:j@x"},
+		},
+		{line: "X-EMPTY:", want: Property{Name: "X-EMPTY"}},
+		{line: "SUMMARY", wantErr: true},
+		{line: ":value", wantErr: true},
+		{line: "DTSTART;TZID", wantErr: true},
+		{line: `ATTENDEE;CN="Doe:x@y`, wantErr: true},
+		{line: "DTSTART;VALUE=DATE", wantErr: true},
+	} {
+		got, err := parseLine(tt.line)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseLine(%q): unexpected error %v", tt.line, err)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseLine(%q): expected %#v, got %#v", tt.line, tt.want, got)
+		}
+	}
+}
+
+func TestFolding(t *testing.T) {
+	long := strings.Repeat("Grüße aus Köln, ", 20)
+	var buf bytes.Buffer
+	w := &writer{w: &buf}
+	w.text("DESCRIPTION", long)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	if len(lines) < 4 {
+		t.Fatalf("expected the line to be folded, got %q", buf.String())
+	}
+	for i, line := range lines {
+		if len(line) > maxLineLength {
+			t.Errorf("line %d is %d octets long", i, len(line))
+		}
+		if i > 0 && line[0] != ' ' {
+			t.Errorf("line %d is not a continuation: %q", i, line)
+		}
+		if strings.ToValidUTF8(line, "?") != line {
+			t.Errorf("line %d splits a UTF-8 sequence: %q", i, line)
+		}
+	}
+	comps, err := readComponents(strings.NewReader("BEGIN:X\r\n" + buf.String() + "END:X\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := unescape(comps[0].Props[0].Value); got != long {
+		t.Errorf("expected unfolded text %q, got %q", long, got)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for _, s := range []string{`a,b;c\d`, "line 1\nline 2", `\n literal`} {
+		if got := unescape(escape(s)); got != s {
+			t.Errorf("escape(%q) does not round-trip: got %q", s, got)
+		}
+	}
+	if got := unescape(`a\Nb\\n`); got != "a\nb\\n" {
+		t.Errorf("unexpected unescape result %q", got)
+	}
+}
@@ -0,0 +1,716 @@
+// Package ical reads and writes iCalendar (RFC 5545) files using dt types.
+//
+// Parse reads the VEVENT, VTODO and VTIMEZONE components of a VCALENDAR,
+// mapping DATE values to dt.Date and DATE-TIME values to dt.DateTime, along
+// with whether they are floating, in UTC or in a time zone named by TZID.
+// RRULE values map to dt.Recurrence, which covers the date-based rule parts;
+// rules using other parts, such as BYHOUR or BYSETPOS, are kept as raw
+// properties and reported by Common.HasUnsupportedRule. Properties and components that are not otherwise handled are
+// kept as well, so that a parsed calendar can be written back with WriteTo.
+//
+// dt types have a resolution of one minute, so the seconds of DATE-TIME
+// values are discarded.
+package ical
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// A Time is the value of a DATE or DATE-TIME property.
+type Time struct {
+	Date dt.Date
+	Time dt.Time // Not Valid for DATE values.
+	UTC  bool    // Whether the time is in UTC.
+	TZID string  // Time zone of a local time; empty for floating times.
+}
+
+// Date returns the Time of a DATE value.
+func Date(d dt.Date) Time {
+	return Time{Date: d}
+}
+
+// Floating returns the Time of a DATE-TIME value that is the same wall-clock
+// time in every time zone.
+func Floating(d dt.DateTime) Time {
+	return Time{Date: d.Date, Time: d.Time}
+}
+
+// UTC returns the Time of a DATE-TIME value in UTC.
+func UTC(d dt.DateTime) Time {
+	return Time{Date: d.Date, Time: d.Time, UTC: true}
+}
+
+// Zoned returns the Time of a DATE-TIME value in the time zone tzid.
+func Zoned(d dt.DateTime, tzid string) Time {
+	return Time{Date: d.Date, Time: d.Time, TZID: tzid}
+}
+
+// IsZero reports whether t holds no value.
+func (t Time) IsZero() bool {
+	return !t.Date.Valid
+}
+
+// IsDate reports whether t is a DATE value.
+func (t Time) IsDate() bool {
+	return t.Date.Valid && !t.Time.Valid
+}
+
+// DateTime returns the date and time of t, which is midnight for DATE values.
+func (t Time) DateTime() dt.DateTime {
+	if !t.Time.Valid {
+		return dt.DateTime{Date: t.Date, Time: dt.Time{Valid: t.Date.Valid}}
+	}
+	return dt.DateTime{Date: t.Date, Time: t.Time}
+}
+
+// String returns t in iCalendar format, e.g. 20240515T090000Z.
+func (t Time) String() string {
+	if !t.Date.Valid {
+		return ""
+	}
+	s := fmt.Sprintf("%04d%02d%02d", t.Date.Year, t.Date.Month, t.Date.Day)
+	if t.Time.Valid {
+		s += fmt.Sprintf("T%02d%02d00", t.Time.Hour, t.Time.Minute)
+		if t.UTC {
+			s += "Z"
+		}
+	}
+	return s
+}
+
+// params returns the parameters describing the type and zone of t.
+func (t Time) params() []Param {
+	switch {
+	case t.IsDate():
+		return []Param{{"VALUE", []string{"DATE"}}}
+	case t.TZID != "" && !t.UTC:
+		return []Param{{"TZID", []string{t.TZID}}}
+	}
+	return nil
+}
+
+// parseTime parses a DATE or DATE-TIME value with the parameters of p.
+func parseTime(p Property, value string) (Time, error) {
+	if p.Param("VALUE") == "DATE" || len(value) == 8 {
+		tm, err := time.Parse("20060102", value)
+		if err != nil {
+			return Time{}, fmt.Errorf("invalid date %q in %s", value, p.Name)
+		}
+		return Date(dt.DateOf(tm)), nil
+	}
+	s, utc := strings.CutSuffix(value, "Z")
+	tm, err := time.Parse("20060102T150405", s)
+	if err != nil {
+		return Time{}, fmt.Errorf("invalid date-time %q in %s", value, p.Name)
+	}
+	d := dt.DateTimeOf(tm)
+	if utc {
+		return UTC(d), nil
+	}
+	return Zoned(d, p.Param("TZID")), nil
+}
+
+// parseTimes parses a comma-separated list of DATE or DATE-TIME values.
+func parseTimes(p Property) ([]Time, error) {
+	var ts []Time
+	for _, v := range strings.Split(p.Value, ",") {
+		t, err := parseTime(p, v)
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	return ts, nil
+}
+
+// A Calendar is a VCALENDAR object.
+type Calendar struct {
+	ProdID     string // Product that created the calendar; written as "-//dt//ical//EN" if empty.
+	Method     string // iTIP method, e.g. "REQUEST"; optional.
+	Events     []Event
+	Todos      []Todo
+	Timezones  []Timezone
+	Props      []Property  // Other properties, such as X-WR-CALNAME.
+	Components []Component // Other components, such as VJOURNAL.
+}
+
+// Common holds the properties shared by events and to-dos.
+type Common struct {
+	UID         string
+	Stamp       Time // DTSTAMP.
+	Start       Time // DTSTART.
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+	RRule       *dt.Recurrence // Nil without a supported RRULE.
+	RDates      []Time         // Additional occurrences, from RDATE.
+	ExDates     []Time         // Excluded occurrences, from EXDATE.
+	Props       []Property     // Other properties.
+	Components  []Component    // Subcomponents, such as VALARM.
+}
+
+// An Event is a VEVENT component.
+type Event struct {
+	Common
+	End      Time          // DTEND; zero if the event has a duration.
+	Duration time.Duration // DURATION, used if End is zero.
+}
+
+// A Todo is a VTODO component.
+type Todo struct {
+	Common
+	Due       Time          // DUE; zero if the to-do has no due time or a duration.
+	Duration  time.Duration // DURATION, counted from Start.
+	Completed Time          // COMPLETED.
+	Priority  int           // PRIORITY, from 1 (highest) to 9; 0 if undefined.
+}
+
+// Timezone returns the VTIMEZONE of c with the given TZID, or nil.
+func (c *Calendar) Timezone(tzid string) *Timezone {
+	if c == nil {
+		return nil
+	}
+	for i := range c.Timezones {
+		if c.Timezones[i].TZID == tzid {
+			return &c.Timezones[i]
+		}
+	}
+	return nil
+}
+
+// Instant returns the time.Time of t. Times with a TZID use the matching
+// VTIMEZONE of c, or the time zone database if c is nil or has none.
+// Floating times and dates are interpreted in loc.
+func (c *Calendar) Instant(t Time, loc *time.Location) (time.Time, error) {
+	if t.IsZero() {
+		return time.Time{}, errors.New("ical: no time")
+	}
+	d := t.DateTime()
+	switch {
+	case t.UTC:
+		return d.In(time.UTC), nil
+	case t.TZID == "" || t.IsDate():
+		return d.In(loc), nil
+	}
+	if tz := c.Timezone(t.TZID); tz != nil {
+		offset, ok := tz.Offset(d)
+		if !ok {
+			return time.Time{}, fmt.Errorf("ical: time zone %s does not cover %v", t.TZID, d)
+		}
+		return d.In(time.FixedZone(t.TZID, offset)), nil
+	}
+	zone, err := time.LoadLocation(t.TZID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ical: unknown time zone %s", t.TZID)
+	}
+	return d.In(zone), nil
+}
+
+// Occurrences returns the start times of c in order: Start, the dates of
+// RRule and the RDates, without the ExDates. Occurrences produced by RRule
+// have the time of day and zone of Start. Rules that are not supported are
+// not expanded; see HasUnsupportedRule.
+//
+// An ExDate excludes the occurrences on its day if it is a DATE value. A
+// DATE-TIME ExDate excludes the occurrences at the same instant if both are
+// in UTC or a time zone, with TZIDs resolved by cal as with Instant, and
+// those at the same wall-clock time otherwise.
+func (c Common) Occurrences(cal *Calendar) iter.Seq[Time] {
+	return func(yield func(Time) bool) {
+		if c.Start.IsZero() {
+			return
+		}
+		excluded := func(t Time) bool {
+			for _, ex := range c.ExDates {
+				if sameOccurrence(cal, ex, t) {
+					return true
+				}
+			}
+			return false
+		}
+		rdates := slices.SortedFunc(slices.Values(c.RDates), func(a, b Time) int {
+			return a.DateTime().Compare(b.DateTime())
+		})
+		var ruleDates iter.Seq[dt.Date] = func(yield func(dt.Date) bool) { yield(c.Start.Date) }
+		if c.RRule != nil {
+			ruleDates = c.RRule.Dates(c.Start.Date)
+		}
+		// DTSTART is always the first occurrence, even if it does not match the rule.
+		times := func(yield func(Time) bool) {
+			if !yield(c.Start) {
+				return
+			}
+			for d := range ruleDates {
+				t := c.Start
+				t.Date = d
+				if d != c.Start.Date && !yield(t) {
+					return
+				}
+			}
+		}
+		var last Time
+		for t := range mergeTimes(times, rdates) {
+			if (last.IsZero() || t.DateTime() != last.DateTime()) && !excluded(t) {
+				if !yield(t) {
+					return
+				}
+			}
+			last = t
+		}
+	}
+}
+
+// sameOccurrence reports whether the ExDate ex excludes the occurrence t.
+func sameOccurrence(cal *Calendar, ex, t Time) bool {
+	switch {
+	case ex.IsDate():
+		return ex.Date == t.Date
+	case t.IsDate():
+		return false
+	case !isAbsolute(ex) || !isAbsolute(t) || (ex.UTC == t.UTC && ex.TZID == t.TZID):
+		return ex.DateTime() == t.DateTime()
+	}
+	a, err := cal.Instant(ex, time.UTC)
+	if err != nil {
+		return false
+	}
+	b, err := cal.Instant(t, time.UTC)
+	return err == nil && a.Equal(b)
+}
+
+// isAbsolute reports whether t is a DATE-TIME value in UTC or in a time zone.
+func isAbsolute(t Time) bool {
+	return t.Time.Valid && (t.UTC || t.TZID != "")
+}
+
+// HasUnsupportedRule reports whether c has an RRULE that is not supported
+// by dt.Recurrence, or an RDATE of periods. Such properties are kept in
+// Props, and the occurrences they define are missing from Occurrences.
+func (c Common) HasUnsupportedRule() bool {
+	return slices.ContainsFunc(c.Props, func(p Property) bool {
+		return p.Name == "RRULE" || (p.Name == "RDATE" && p.Param("VALUE") == "PERIOD")
+	})
+}
+
+// mergeTimes merges the ascending sequence seq with the sorted times ts.
+func mergeTimes(seq iter.Seq[Time], ts []Time) iter.Seq[Time] {
+	return func(yield func(Time) bool) {
+		for t := range seq {
+			for len(ts) > 0 && ts[0].DateTime().Compare(t.DateTime()) < 0 {
+				if !yield(ts[0]) {
+					return
+				}
+				ts = ts[1:]
+			}
+			if !yield(t) {
+				return
+			}
+		}
+		for _, t := range ts {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Parse reads the first VCALENDAR from r. Failures are reported as an *Error.
+func Parse(r io.Reader) (*Calendar, error) {
+	roots, err := readComponents(r)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(roots, func(c Component) bool { return c.Name == "VCALENDAR" })
+	if i < 0 {
+		return nil, &Error{1, errors.New("missing VCALENDAR")}
+	}
+	root := roots[i]
+	cal := &Calendar{}
+	for _, p := range root.Props {
+		switch p.Name {
+		case "PRODID":
+			cal.ProdID = unescape(p.Value)
+		case "METHOD":
+			cal.Method = p.Value
+		case "VERSION", "CALSCALE":
+		default:
+			cal.Props = append(cal.Props, p)
+		}
+	}
+	for _, c := range root.Components {
+		var err error
+		switch c.Name {
+		case "VEVENT":
+			var e Event
+			err = decodeComponent(c, &e.Common, e.decodeProp)
+			cal.Events = append(cal.Events, e)
+		case "VTODO":
+			var t Todo
+			err = decodeComponent(c, &t.Common, t.decodeProp)
+			cal.Todos = append(cal.Todos, t)
+		case "VTIMEZONE":
+			var tz Timezone
+			tz, err = decodeTimezone(c)
+			cal.Timezones = append(cal.Timezones, tz)
+		default:
+			cal.Components = append(cal.Components, c)
+		}
+		if err != nil {
+			return nil, &Error{c.line, fmt.Errorf("%s: %w", c.Name, err)}
+		}
+	}
+	// An UNTIL date-time may be in UTC, and is converted to the zone of the
+	// start once all VTIMEZONEs are known.
+	events, todos := cal.Events, cal.Todos
+	for _, c := range root.Components {
+		var common *Common
+		switch c.Name {
+		case "VEVENT":
+			common, events = &events[0].Common, events[1:]
+		case "VTODO":
+			common, todos = &todos[0].Common, todos[1:]
+		default:
+			continue
+		}
+		for _, p := range c.Props {
+			if p.Name == "RRULE" {
+				cal.localUntil(common, ruleUntil(p.Value))
+			}
+		}
+	}
+	return cal, nil
+}
+
+// ruleUntil returns the UNTIL value of an RRULE, or an empty string.
+func ruleUntil(rule string) string {
+	for _, part := range strings.Split(rule, ";") {
+		if name, value, _ := strings.Cut(part, "="); strings.EqualFold(name, "UNTIL") {
+			return strings.ToUpper(value)
+		}
+	}
+	return ""
+}
+
+// decodeComponent decodes the properties of c into common, passing the
+// properties that are not common to decode first.
+func decodeComponent(c Component, common *Common, decode func(Property) (bool, error)) error {
+	common.Components = c.Components
+	for _, p := range c.Props {
+		ok, err := decode(p)
+		if err == nil && !ok {
+			ok, err = common.decodeProp(p)
+		}
+		if err != nil {
+			return err
+		}
+		if !ok {
+			common.Props = append(common.Props, p)
+		}
+	}
+	return nil
+}
+
+// decodeProp decodes p into c and reports whether it is a common property.
+func (c *Common) decodeProp(p Property) (bool, error) {
+	var err error
+	switch p.Name {
+	case "UID":
+		c.UID = unescape(p.Value)
+	case "SUMMARY":
+		c.Summary = unescape(p.Value)
+	case "DESCRIPTION":
+		c.Description = unescape(p.Value)
+	case "LOCATION":
+		c.Location = unescape(p.Value)
+	case "STATUS":
+		c.Status = p.Value
+	case "DTSTAMP":
+		c.Stamp, err = parseTime(p, p.Value)
+	case "DTSTART":
+		c.Start, err = parseTime(p, p.Value)
+	case "RRULE":
+		r, err := dt.ParseRecurrence(p.Value)
+		if err != nil {
+			return false, nil
+		}
+		c.RRule = &r
+	case "RDATE", "EXDATE":
+		if p.Param("VALUE") == "PERIOD" {
+			return false, nil
+		}
+		var ts []Time
+		if ts, err = parseTimes(p); p.Name == "RDATE" {
+			c.RDates = append(c.RDates, ts...)
+		} else {
+			c.ExDates = append(c.ExDates, ts...)
+		}
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (e *Event) decodeProp(p Property) (bool, error) {
+	var err error
+	switch p.Name {
+	case "DTEND":
+		e.End, err = parseTime(p, p.Value)
+	case "DURATION":
+		e.Duration, err = parseDuration(p.Value)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (t *Todo) decodeProp(p Property) (bool, error) {
+	var err error
+	switch p.Name {
+	case "DUE":
+		t.Due, err = parseTime(p, p.Value)
+	case "COMPLETED":
+		t.Completed, err = parseTime(p, p.Value)
+	case "DURATION":
+		t.Duration, err = parseDuration(p.Value)
+	case "PRIORITY":
+		t.Priority, err = strconv.Atoi(p.Value)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// parseDuration parses a DURATION value such as -P1DT2H or P2W.
+func parseDuration(s string) (time.Duration, error) {
+	rest, neg := strings.CutPrefix(s, "-")
+	rest = strings.TrimPrefix(rest, "+")
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok || rest == "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	var d time.Duration
+	for rest != "" {
+		if rest[0] == 'T' {
+			// The time part must follow the date part once, and not be empty.
+			if units['H'] != 0 || len(rest) == 1 {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+			rest = rest[1:]
+			continue
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 || units[rest[i]] == 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		n, _ := strconv.Atoi(rest[:i])
+		d += time.Duration(n) * units[rest[i]]
+		rest = rest[i+1:]
+	}
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// formatDuration returns d as a DURATION value.
+func formatDuration(d time.Duration) string {
+	s := "P"
+	if d < 0 {
+		s, d = "-P", -d
+	}
+	if days := d / (24 * time.Hour); days > 0 {
+		s += strconv.Itoa(int(days)) + "D"
+		d -= days * 24 * time.Hour
+	}
+	if d == 0 && s != "P" && s != "-P" {
+		return s
+	}
+	s += "T"
+	for _, u := range []struct {
+		d    time.Duration
+		name string
+	}{{time.Hour, "H"}, {time.Minute, "M"}, {time.Second, "S"}} {
+		if n := d / u.d; n > 0 {
+			s += strconv.Itoa(int(n)) + u.name
+			d -= n * u.d
+		}
+	}
+	if strings.HasSuffix(s, "T") {
+		s += "0S"
+	}
+	return s
+}
+
+// WriteTo writes c to w in iCalendar format, implementing io.WriterTo.
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	wr := &writer{w: w}
+	wr.prop("BEGIN", nil, "VCALENDAR")
+	wr.prop("VERSION", nil, "2.0")
+	prodID := cmp.Or(c.ProdID, "-//dt//ical//EN")
+	wr.prop("PRODID", nil, escape(prodID))
+	if c.Method != "" {
+		wr.prop("METHOD", nil, c.Method)
+	}
+	for _, p := range c.Props {
+		wr.prop(p.Name, p.Params, p.Value)
+	}
+	for _, tz := range c.Timezones {
+		tz.write(wr)
+	}
+	for _, e := range c.Events {
+		wr.prop("BEGIN", nil, "VEVENT")
+		e.Common.write(wr, c, func() {
+			wr.time("DTEND", e.End)
+			if e.End.IsZero() && e.Duration != 0 {
+				wr.prop("DURATION", nil, formatDuration(e.Duration))
+			}
+		})
+		wr.prop("END", nil, "VEVENT")
+	}
+	for _, t := range c.Todos {
+		wr.prop("BEGIN", nil, "VTODO")
+		t.Common.write(wr, c, func() {
+			wr.time("DUE", t.Due)
+			if t.Due.IsZero() && t.Duration != 0 {
+				wr.prop("DURATION", nil, formatDuration(t.Duration))
+			}
+			wr.time("COMPLETED", t.Completed)
+			if t.Priority != 0 {
+				wr.prop("PRIORITY", nil, strconv.Itoa(t.Priority))
+			}
+		})
+		wr.prop("END", nil, "VTODO")
+	}
+	for _, sub := range c.Components {
+		wr.component(sub)
+	}
+	wr.prop("END", nil, "VCALENDAR")
+	return wr.n, wr.err
+}
+
+// write writes the properties of c, calling specific after DTSTART to write
+// the properties of the component type. Time zones are resolved by cal.
+func (c Common) write(w *writer, cal *Calendar, specific func()) {
+	w.text("UID", c.UID)
+	w.time("DTSTAMP", c.Stamp)
+	w.time("DTSTART", c.Start)
+	specific()
+	w.text("SUMMARY", c.Summary)
+	w.text("DESCRIPTION", c.Description)
+	w.text("LOCATION", c.Location)
+	if c.Status != "" {
+		w.prop("STATUS", nil, c.Status)
+	}
+	if c.RRule != nil {
+		w.prop("RRULE", nil, formatRule(*c.RRule, c.Start, cal))
+	}
+	for _, t := range c.RDates {
+		w.time("RDATE", t)
+	}
+	for _, t := range c.ExDates {
+		w.time("EXDATE", t)
+	}
+	for _, p := range c.Props {
+		w.prop(p.Name, p.Params, p.Value)
+	}
+	for _, sub := range c.Components {
+		w.component(sub)
+	}
+}
+
+// formatRule returns r as an RRULE value. RFC 5545 requires UNTIL to have
+// the type of DTSTART, and to be in UTC if DTSTART is not floating, so for
+// DATE-TIME starts UNTIL is written as the end of its day in the zone of
+// the start, resolved by cal as with Instant. If that zone cannot be
+// resolved, the end of the day in UTC is written.
+func formatRule(r dt.Recurrence, start Time, cal *Calendar) string {
+	if !r.Until.Valid || !start.Time.Valid {
+		return r.String()
+	}
+	until := fmt.Sprintf("%04d%02d%02dT235959", r.Until.Year, r.Until.Month, r.Until.Day)
+	switch {
+	case start.UTC:
+		until += "Z"
+	case start.TZID != "":
+		end := Zoned(dt.DateTime{Date: r.Until.AddDays(1), Time: dt.Time{Valid: true}}, start.TZID)
+		if t, err := cal.Instant(end, time.UTC); err == nil {
+			until = t.Add(-time.Second).UTC().Format("20060102T150405Z")
+		} else {
+			until += "Z"
+		}
+	}
+	r.Until = dt.Date{}
+	return r.String() + ";UNTIL=" + until
+}
+
+// localUntil sets the Until date of the rule of common, which was parsed from an
+// RRULE with the UNTIL value until, to the date of the last occurrence that
+// does not start after UNTIL in the zone of the start.
+func (c *Calendar) localUntil(common *Common, until string) {
+	start := common.Start
+	if common.RRule == nil || !start.Time.Valid {
+		return
+	}
+	s, utc := strings.CutSuffix(until, "Z")
+	tm, err := time.Parse("20060102T150405", s)
+	if err != nil {
+		return
+	}
+	local := dt.DateTimeOf(tm)
+	if utc && !start.UTC && start.TZID != "" {
+		if local, err = c.local(tm, start.TZID); err != nil {
+			return
+		}
+	}
+	common.RRule.Until = local.Date
+	if local.Time.Before(start.Time) {
+		common.RRule.Until = local.Date.AddDays(-1)
+	}
+}
+
+// local returns the local time in the time zone tzid of the instant t,
+// using the matching VTIMEZONE of c, or the time zone database if c has none.
+func (c *Calendar) local(t time.Time, tzid string) (dt.DateTime, error) {
+	tz := c.Timezone(tzid)
+	if tz == nil {
+		zone, err := time.LoadLocation(tzid)
+		if err != nil {
+			return dt.DateTime{}, fmt.Errorf("ical: unknown time zone %s", tzid)
+		}
+		return dt.DateTimeOf(t.In(zone)), nil
+	}
+	// Offsets are given for local times, so guess the local time with the
+	// offset at the UTC time, then correct it with the offset found there.
+	utc := dt.DateTimeOf(t.UTC())
+	guess, _ := tz.Offset(utc)
+	offset, ok := tz.Offset(dt.DateTimeOf(t.UTC().Add(time.Duration(guess) * time.Second)))
+	if !ok {
+		return dt.DateTime{}, fmt.Errorf("ical: time zone %s does not cover %v", tzid, utc)
+	}
+	return dt.DateTimeOf(t.UTC().Add(time.Duration(offset) * time.Second)), nil
+}
+
+func (w *writer) text(name, value string) {
+	if value != "" {
+		w.prop(name, nil, escape(value))
+	}
+}
+
+func (w *writer) time(name string, t Time) {
+	if !t.IsZero() {
+		w.prop(name, t.params(), t.String())
+	}
+}
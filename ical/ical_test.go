@@ -0,0 +1,311 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func parseFile(t *testing.T, name string) *Calendar {
+	t.Helper()
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	cal, err := Parse(f)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return cal
+}
+
+func TestParse(t *testing.T) {
+	cal := parseFile(t, "testdata/berlin.ics")
+	if cal.ProdID != "-//Example Corp.//Calendar 1.0//EN" || len(cal.Props) != 1 || cal.Props[0].Name != "X-WR-CALNAME" {
+		t.Errorf("unexpected calendar properties: %q %v", cal.ProdID, cal.Props)
+	}
+	if len(cal.Events) != 3 || len(cal.Todos) != 1 || len(cal.Timezones) != 1 || len(cal.Components) != 1 {
+		t.Fatalf("unexpected components: %d events, %d to-dos, %d time zones, %d others",
+			len(cal.Events), len(cal.Todos), len(cal.Timezones), len(cal.Components))
+	}
+
+	standup := cal.Events[0]
+	start := dt.DateTime{Date: dt.Date{Year: 2024, Month: time.May, Day: 6, Valid: true}, Time: dt.Time{Hour: 9, Minute: 30, Valid: true}}
+	if standup.Start != Zoned(start, "Europe/Berlin") {
+		t.Errorf("unexpected DTSTART %#v", standup.Start)
+	}
+	if standup.End.Time != (dt.Time{Hour: 9, Minute: 45, Valid: true}) {
+		t.Errorf("unexpected DTEND %#v", standup.End)
+	}
+	if standup.Summary != "Stand-up, daily" || !strings.HasPrefix(standup.Description, "Agenda:\n1. Yesterday\n2. Today; blockers. This line is long enough") {
+		t.Errorf("unexpected text: %q, %q", standup.Summary, standup.Description)
+	}
+	if standup.RRule == nil || standup.RRule.String() != "FREQ=WEEKLY;COUNT=6;BYDAY=MO,WE,FR" {
+		t.Errorf("unexpected RRULE %v", standup.RRule)
+	}
+	if len(standup.Props) != 1 || standup.Props[0].Param("CN") != "Doe, Jane" || standup.Props[0].Value != "mailto:jane@example.com" {
+		t.Errorf("unexpected properties %v", standup.Props)
+	}
+	if len(standup.Components) != 1 || standup.Components[0].Name != "VALARM" {
+		t.Errorf("unexpected subcomponents %v", standup.Components)
+	}
+
+	holiday := cal.Events[1]
+	if !holiday.Start.IsDate() || holiday.Start.Date != (dt.Date{Year: 2024, Month: time.December, Day: 25, Valid: true}) || holiday.Duration != 24*time.Hour {
+		t.Errorf("unexpected holiday %v for %v", holiday.Start, holiday.Duration)
+	}
+	if ship := cal.Events[2]; ship.RRule != nil || !ship.Start.UTC || len(ship.Props) != 1 || ship.Props[0].Name != "RRULE" || !ship.HasUnsupportedRule() {
+		t.Errorf("expected unsupported RRULE to be kept as a property, got %v and %v", ship.RRule, ship.Props)
+	}
+	if standup.HasUnsupportedRule() || holiday.HasUnsupportedRule() {
+		t.Error("expected supported rules")
+	}
+
+	todo := cal.Todos[0]
+	if todo.Priority != 1 || todo.Status != "NEEDS-ACTION" || todo.Due.TZID != "" || todo.Due.UTC || todo.Due.Date.Day != 17 {
+		t.Errorf("unexpected to-do %+v", todo)
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	cal := parseFile(t, "testdata/berlin.ics")
+	var got []string
+	for o := range cal.Events[0].Occurrences(cal) {
+		got = append(got, o.String())
+	}
+	want := []string{"20240506T093000", "20240510T093000", "20240511T093000", "20240513T093000", "20240515T093000", "20240517T093000"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	got = nil
+	for o := range cal.Events[1].Occurrences(cal) {
+		got = append(got, o.String())
+	}
+	if want := []string{"20241225", "20251225", "20261225"}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestOccurrencesExDates(t *testing.T) {
+	at := func(d, h int) dt.DateTime {
+		return dt.DateTime{Date: dt.Date{Year: 2024, Month: time.January, Day: d, Valid: true}, Time: dt.Time{Hour: h, Valid: true}}
+	}
+	berlin := parseFile(t, "testdata/berlin.ics")
+	rule := &dt.Recurrence{Freq: dt.Weekly, Count: 3, WeekStart: time.Monday}
+	for _, tt := range []struct {
+		name  string
+		start Time
+		ex    Time
+		want  []string
+	}{
+		{"UTC instant", Zoned(at(1, 9), "Europe/Berlin"), UTC(at(8, 8)), []string{"20240101T090000", "20240115T090000"}},
+		{"UTC wall clock", Zoned(at(1, 9), "Europe/Berlin"), UTC(at(8, 9)), []string{"20240101T090000", "20240108T090000", "20240115T090000"}},
+		{"other zone", Zoned(at(1, 9), "Europe/Berlin"), Zoned(at(8, 17), "Asia/Tokyo"), []string{"20240101T090000", "20240115T090000"}},
+		{"zoned start in UTC", UTC(at(1, 8)), Zoned(at(8, 9), "Europe/Berlin"), []string{"20240101T080000Z", "20240115T080000Z"}},
+		{"floating", Floating(at(1, 9)), Zoned(at(8, 9), "Europe/Berlin"), []string{"20240101T090000", "20240115T090000"}},
+		{"date", Zoned(at(1, 9), "Europe/Berlin"), Date(at(8, 0).Date), []string{"20240101T090000", "20240115T090000"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Common{Start: tt.start, RRule: rule, ExDates: []Time{tt.ex}}
+			for _, cal := range []*Calendar{berlin, nil} {
+				var got []string
+				for o := range c.Occurrences(cal) {
+					got = append(got, o.String())
+				}
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestInstant(t *testing.T) {
+	cal := parseFile(t, "testdata/berlin.ics")
+	at := func(y int, m time.Month, d, h, min int) dt.DateTime {
+		return dt.DateTime{Date: dt.Date{Year: y, Month: m, Day: d, Valid: true}, Time: dt.Time{Hour: h, Minute: min, Valid: true}}
+	}
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	for _, tt := range []struct {
+		t    Time
+		want time.Time
+	}{
+		{Zoned(at(2024, time.July, 1, 9, 0), "Europe/Berlin"), time.Date(2024, time.July, 1, 7, 0, 0, 0, time.UTC)},
+		{Zoned(at(2024, time.January, 15, 9, 0), "Europe/Berlin"), time.Date(2024, time.January, 15, 8, 0, 0, 0, time.UTC)},
+		{Zoned(at(2024, time.March, 31, 1, 59), "Europe/Berlin"), time.Date(2024, time.March, 31, 0, 59, 0, 0, time.UTC)},
+		{Zoned(at(2024, time.March, 31, 3, 0), "Europe/Berlin"), time.Date(2024, time.March, 31, 1, 0, 0, 0, time.UTC)},
+		{Zoned(at(2024, time.July, 1, 9, 0), "Asia/Tokyo"), time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{UTC(at(2024, time.July, 1, 9, 0)), time.Date(2024, time.July, 1, 9, 0, 0, 0, time.UTC)},
+		{Floating(at(2024, time.July, 1, 9, 0)), time.Date(2024, time.July, 1, 9, 0, 0, 0, tokyo)},
+		{Date(at(2024, time.July, 1, 0, 0).Date), time.Date(2024, time.July, 1, 0, 0, 0, 0, tokyo)},
+	} {
+		got, err := cal.Instant(tt.t, tokyo)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("Instant(%#v): expected %v, got %v (error %v)", tt.t, tt.want, got, err)
+		}
+	}
+	for _, bad := range []Time{{}, Zoned(at(2024, time.July, 1, 9, 0), "Nowhere/City"), Zoned(at(1960, time.July, 1, 9, 0), "Europe/Berlin")} {
+		if _, err := cal.Instant(bad, time.UTC); err == nil {
+			t.Errorf("Instant(%#v): expected error", bad)
+		}
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	cal := parseFile(t, "testdata/berlin.ics")
+	var buf bytes.Buffer
+	if _, err := cal.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 octets: %q", line)
+		}
+	}
+	for _, want := range []string{
+		"DTSTART;TZID=Europe/Berlin:20240506T093000\r\n",
+		"SUMMARY:Stand-up\\, daily\r\n",
+		"ATTENDEE;CN=\"Doe, Jane\";ROLE=REQ-PARTICIPANT:mailto:jane@example.com\r\n",
+		"DTSTART;VALUE=DATE:20241225\r\nDURATION:P1D\r\n",
+		"RRULE:FREQ=YEARLY;UNTIL=20261225\r\n",
+		"RRULE:FREQ=DAILY;BYHOUR=9,17\r\n",
+		"BEGIN:VALARM\r\nACTION:DISPLAY\r\nTRIGGER:-PT10M\r\nEND:VALARM\r\n",
+		"BEGIN:VJOURNAL\r\n",
+		"TZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\nTZNAME:CEST\r\nRRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+
+	again, err := Parse(strings.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf2 bytes.Buffer
+	again.WriteTo(&buf2)
+	if buf2.String() != out {
+		t.Errorf("writing a parsed calendar changed it:\n%s\n%s", out, buf2.String())
+	}
+}
+
+func TestWriteRule(t *testing.T) {
+	until := dt.Date{Year: 2024, Month: time.June, Day: 30, Valid: true}
+	start := dt.DateTime{Date: dt.Date{Year: 2024, Month: time.May, Day: 6, Valid: true}, Time: dt.Time{Hour: 9, Valid: true}}
+	rule := &dt.Recurrence{Freq: dt.Weekly, Until: until, WeekStart: time.Monday}
+	for _, tt := range []struct {
+		start Time
+		want  string
+	}{
+		{Date(start.Date), "RRULE:FREQ=WEEKLY;UNTIL=20240630\r\n"},
+		{Floating(start), "RRULE:FREQ=WEEKLY;UNTIL=20240630T235959\r\n"},
+		{UTC(start), "RRULE:FREQ=WEEKLY;UNTIL=20240630T235959Z\r\n"},
+		{Zoned(start, "Europe/Berlin"), "RRULE:FREQ=WEEKLY;UNTIL=20240630T215959Z\r\n"},
+		{Zoned(start, "America/New_York"), "RRULE:FREQ=WEEKLY;UNTIL=20240701T035959Z\r\n"},
+	} {
+		cal := &Calendar{Events: []Event{{Common: Common{UID: "x", Start: tt.start, RRule: rule}}}}
+		var buf bytes.Buffer
+		cal.WriteTo(&buf)
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Errorf("expected %q in\n%s", tt.want, buf.String())
+		}
+		back, err := Parse(&buf)
+		if err != nil || back.Events[0].RRule.Until != until {
+			t.Errorf("expected UNTIL to parse back as %v, got %v (error %v)", until, back.Events[0].RRule, err)
+		}
+	}
+}
+
+func TestParseUntil(t *testing.T) {
+	for _, tt := range []struct {
+		start, until string
+		want         dt.Date
+	}{
+		{"DTSTART;TZID=Europe/Berlin:20240506T093000", "20240510T073000Z", dt.Date{Year: 2024, Month: time.May, Day: 10, Valid: true}},
+		{"DTSTART;TZID=Europe/Berlin:20240506T093000", "20240510T072900Z", dt.Date{Year: 2024, Month: time.May, Day: 9, Valid: true}},
+		{"DTSTART;TZID=America/New_York:20240506T210000", "20240511T010000Z", dt.Date{Year: 2024, Month: time.May, Day: 10, Valid: true}},
+		{"DTSTART:20240506T093000Z", "20240510T093000Z", dt.Date{Year: 2024, Month: time.May, Day: 10, Valid: true}},
+		{"DTSTART:20240506T093000", "20240510T090000", dt.Date{Year: 2024, Month: time.May, Day: 9, Valid: true}},
+		{"DTSTART;VALUE=DATE:20240506", "20240510", dt.Date{Year: 2024, Month: time.May, Day: 10, Valid: true}},
+	} {
+		in := "BEGIN:VCALENDAR\nBEGIN:VEVENT\n" + tt.start + "\nRRULE:FREQ=DAILY;UNTIL=" + tt.until + "\nEND:VEVENT\nEND:VCALENDAR\n"
+		cal, err := Parse(strings.NewReader(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := cal.Events[0].RRule.Until; got != tt.want {
+			t.Errorf("%s with UNTIL=%s: expected %v, got %v", tt.start, tt.until, tt.want, got)
+		}
+	}
+	// A VTIMEZONE unknown to the time zone database is used to convert UNTIL.
+	cal := parseFile(t, "testdata/berlin.ics")
+	var buf bytes.Buffer
+	cal.WriteTo(&buf)
+	in := strings.ReplaceAll(buf.String(), "Europe/Berlin", "Example/Berlin")
+	in = strings.Replace(in, "COUNT=6", "UNTIL=20240510T073000Z", 1)
+	if cal, err := Parse(strings.NewReader(in)); err != nil || cal.Events[0].RRule.Until != (dt.Date{Year: 2024, Month: time.May, Day: 10, Valid: true}) {
+		t.Errorf("expected UNTIL in the VTIMEZONE, got %v (error %v)", cal.Events[0].RRule, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   string
+		line int
+	}{
+		{"no calendar", "BEGIN:VEVENT\nEND:VEVENT\n", 1},
+		{"missing END", "BEGIN:VCALENDAR\nBEGIN:VEVENT\n", 2},
+		{"mismatched END", "BEGIN:VCALENDAR\nBEGIN:VEVENT\nEND:VCALENDAR\n", 3},
+		{"property outside component", "SUMMARY:x\n", 1},
+		{"bad line", "BEGIN:VCALENDAR\nSUMMARY\nEND:VCALENDAR\n", 2},
+		{"bad date", "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20241345T000000\nEND:VEVENT\nEND:VCALENDAR\n", 2},
+		{"bad duration", "BEGIN:VCALENDAR\n\nBEGIN:VEVENT\nDURATION:P1X\nEND:VEVENT\nEND:VCALENDAR\n", 3},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.in))
+			var e *Error
+			if !errors.As(err, &e) {
+				t.Fatalf("expected *Error, got %v", err)
+			}
+			if e.Line != tt.line {
+				t.Errorf("expected line %d, got %d (%v)", tt.line, e.Line, err)
+			}
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	for _, tt := range []struct {
+		s string
+		d time.Duration
+	}{
+		{"PT0S", 0},
+		{"P1D", 24 * time.Hour},
+		{"-PT10M", -10 * time.Minute},
+		{"P1DT2H30M5S", 26*time.Hour + 30*time.Minute + 5*time.Second},
+		{"PT36H", 36 * time.Hour},
+		{"P2W", 14 * 24 * time.Hour},
+	} {
+		d, err := parseDuration(tt.s)
+		if err != nil || d != tt.d {
+			t.Errorf("parseDuration(%q): expected %v, got %v (error %v)", tt.s, tt.d, d, err)
+		}
+		if back, _ := parseDuration(formatDuration(tt.d)); back != tt.d {
+			t.Errorf("formatDuration(%v) = %q does not parse back", tt.d, formatDuration(tt.d))
+		}
+	}
+	for _, s := range []string{"", "P", "1D", "PT1D", "P1H", "PXD", "PT", "P1DT", "PT1HT1M"} {
+		if _, err := parseDuration(s); err == nil {
+			t.Errorf("parseDuration(%q): expected error", s)
+		}
+	}
+}
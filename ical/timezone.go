@@ -0,0 +1,208 @@
+package ical
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+// A Timezone is a VTIMEZONE component, describing the UTC offsets used by a
+// time zone over time.
+type Timezone struct {
+	TZID        string
+	Observances []Observance
+	Props       []Property // Other properties, such as LAST-MODIFIED.
+}
+
+// An Observance is a STANDARD or DAYLIGHT subcomponent of a VTIMEZONE: an
+// offset that takes effect at Start and again at each date of RRule and RDates.
+type Observance struct {
+	Daylight   bool
+	Name       string      // TZNAME, e.g. "CEST".
+	Start      dt.DateTime // DTSTART, in the local time before the onset.
+	OffsetFrom int         // TZOFFSETFROM in seconds east of UTC.
+	OffsetTo   int         // TZOFFSETTO in seconds east of UTC.
+	RRule      *dt.Recurrence
+	RDates     []dt.DateTime
+	Props      []Property
+}
+
+// Offset returns the UTC offset in seconds of the local time d in tz, and
+// whether any observance of tz took effect before d. Onsets are compared to
+// d in local time, so times skipped at a transition get the offset after it
+// and times repeated at a transition the offset before it.
+func (tz *Timezone) Offset(d dt.DateTime) (int, bool) {
+	var (
+		offset int
+		latest dt.DateTime
+		found  bool
+	)
+	for _, o := range tz.Observances {
+		if onset, ok := o.lastOnset(d); ok && (!found || latest.Before(onset)) {
+			offset, latest, found = o.OffsetTo, onset, true
+		}
+	}
+	return offset, found
+}
+
+// lastOnset returns the last onset of o that is not after d.
+func (o Observance) lastOnset(d dt.DateTime) (dt.DateTime, bool) {
+	var last dt.DateTime
+	found := false
+	consider := func(onset dt.DateTime) {
+		if !d.Before(onset) && (!found || last.Before(onset)) {
+			last, found = onset, true
+		}
+	}
+	consider(o.Start)
+	for _, r := range o.RDates {
+		consider(r)
+	}
+	if o.RRule != nil {
+		for date := range o.RRule.Dates(skipYears(*o.RRule, o.Start.Date, d.Date)) {
+			onset := dt.DateTime{Date: date, Time: o.Start.Time}
+			if d.Before(onset) {
+				break
+			}
+			consider(onset)
+		}
+	}
+	return last, found
+}
+
+// skipYears returns start moved forward by whole intervals of the yearly
+// rule r to the year before d, which produces the same dates from then on.
+// Other rules, and rules with a COUNT, are returned unchanged.
+func skipYears(r dt.Recurrence, start, d dt.Date) dt.Date {
+	interval := max(r.Interval, 1)
+	if r.Freq != dt.Yearly || r.Count > 0 || (start.Month == time.February && start.Day == 29) {
+		return start
+	}
+	if n := (d.Year - 1 - start.Year) / interval; n > 0 {
+		return start.AddYears(n * interval)
+	}
+	return start
+}
+
+// decodeTimezone decodes a VTIMEZONE component.
+func decodeTimezone(c Component) (Timezone, error) {
+	var tz Timezone
+	for _, p := range c.Props {
+		if p.Name == "TZID" {
+			tz.TZID = p.Value
+			continue
+		}
+		tz.Props = append(tz.Props, p)
+	}
+	for _, sub := range c.Components {
+		if sub.Name != "STANDARD" && sub.Name != "DAYLIGHT" {
+			continue
+		}
+		o := Observance{Daylight: sub.Name == "DAYLIGHT"}
+		for _, p := range sub.Props {
+			var err error
+			switch p.Name {
+			case "TZNAME":
+				o.Name = unescape(p.Value)
+			case "DTSTART":
+				var t Time
+				t, err = parseTime(p, p.Value)
+				o.Start = t.DateTime()
+			case "TZOFFSETFROM":
+				o.OffsetFrom, err = parseOffset(p.Value)
+			case "TZOFFSETTO":
+				o.OffsetTo, err = parseOffset(p.Value)
+			case "RRULE":
+				r, err := dt.ParseRecurrence(p.Value)
+				if err != nil {
+					o.Props = append(o.Props, p)
+					continue
+				}
+				o.RRule = &r
+			case "RDATE":
+				var ts []Time
+				ts, err = parseTimes(p)
+				for _, t := range ts {
+					o.RDates = append(o.RDates, t.DateTime())
+				}
+			default:
+				o.Props = append(o.Props, p)
+			}
+			if err != nil {
+				return tz, err
+			}
+		}
+		tz.Observances = append(tz.Observances, o)
+	}
+	return tz, nil
+}
+
+// parseOffset parses a UTC offset such as +0200 or -033000.
+func parseOffset(s string) (int, error) {
+	if (len(s) != 5 && len(s) != 7) || (s[0] != '+' && s[0] != '-') {
+		return 0, fmt.Errorf("invalid UTC offset %q", s)
+	}
+	seconds := 0
+	for i, unit := range []int{3600, 60, 1}[:(len(s)-1)/2] {
+		n, err := strconv.Atoi(s[1+2*i : 3+2*i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid UTC offset %q", s)
+		}
+		seconds += n * unit
+	}
+	if s[0] == '-' {
+		seconds = -seconds
+	}
+	return seconds, nil
+}
+
+// formatOffset returns the offset in seconds as a UTC-OFFSET value.
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
+
+// write writes tz as a VTIMEZONE component.
+func (tz Timezone) write(w *writer) {
+	w.prop("BEGIN", nil, "VTIMEZONE")
+	w.prop("TZID", nil, tz.TZID)
+	for _, p := range tz.Props {
+		w.prop(p.Name, p.Params, p.Value)
+	}
+	for _, o := range tz.Observances {
+		name := "STANDARD"
+		if o.Daylight {
+			name = "DAYLIGHT"
+		}
+		w.prop("BEGIN", nil, name)
+		w.time("DTSTART", Floating(o.Start))
+		w.prop("TZOFFSETFROM", nil, formatOffset(o.OffsetFrom))
+		w.prop("TZOFFSETTO", nil, formatOffset(o.OffsetTo))
+		w.text("TZNAME", o.Name)
+		if o.RRule != nil {
+			w.prop("RRULE", nil, formatRule(*o.RRule, UTC(o.Start), nil))
+		}
+		if len(o.RDates) > 0 {
+			values := make([]string, len(o.RDates))
+			for i, r := range o.RDates {
+				values[i] = Floating(r).String()
+			}
+			w.prop("RDATE", nil, strings.Join(values, ","))
+		}
+		for _, p := range o.Props {
+			w.prop(p.Name, p.Params, p.Value)
+		}
+		w.prop("END", nil, name)
+	}
+	w.prop("END", nil, "VTIMEZONE")
+}
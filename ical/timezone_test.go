@@ -0,0 +1,90 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ribice/dt"
+)
+
+func TestTimezoneOffset(t *testing.T) {
+	cal := parseFile(t, "testdata/berlin.ics")
+	tz := cal.Timezone("Europe/Berlin")
+	if tz == nil || len(tz.Observances) != 2 || !tz.Observances[0].Daylight || tz.Observances[0].Name != "CEST" {
+		t.Fatalf("unexpected time zone %+v", tz)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	// The rules match the time zone database since 1996.
+	start := time.Date(1996, time.January, 1, 0, 0, 0, 0, berlin)
+	for h := 0; h < 30*365*24; h += 31 {
+		tm := start.Add(time.Duration(h) * time.Hour)
+		if w := dt.DateTimeOf(tm); dt.DateTimeOf(tm.Add(-time.Hour)) == w || dt.DateTimeOf(tm.Add(time.Hour)) == w {
+			continue // Repeated when clocks go back.
+		}
+		_, want := tm.Zone()
+		got, ok := tz.Offset(dt.DateTimeOf(tm))
+		if !ok || got != want {
+			t.Fatalf("Offset(%v): expected %d, got %d", tm, want, got)
+		}
+	}
+	if _, ok := tz.Offset(dt.DateTime{Date: dt.Date{Year: 1969, Month: time.December, Day: 31, Valid: true}, Time: dt.Time{Valid: true}}); ok {
+		t.Error("expected no offset before the first observance")
+	}
+}
+
+func TestOffsetFormat(t *testing.T) {
+	for _, tt := range []struct {
+		s       string
+		seconds int
+	}{
+		{"+0000", 0},
+		{"+0200", 7200},
+		{"-0330", -12600},
+		{"+053045", 19845},
+	} {
+		got, err := parseOffset(tt.s)
+		if err != nil || got != tt.seconds {
+			t.Errorf("parseOffset(%q): expected %d, got %d (error %v)", tt.s, tt.seconds, got, err)
+		}
+		if s := formatOffset(tt.seconds); s != tt.s {
+			t.Errorf("formatOffset(%d): expected %q, got %q", tt.seconds, tt.s, s)
+		}
+	}
+	for _, s := range []string{"", "0200", "+02", "+02:00", "+0a00"} {
+		if _, err := parseOffset(s); err == nil {
+			t.Errorf("parseOffset(%q): expected error", s)
+		}
+	}
+}
+
+func TestDecodeTimezoneRDate(t *testing.T) {
+	in := "BEGIN:VCALENDAR\r\nBEGIN:VTIMEZONE\r\nTZID:Test\r\nBEGIN:STANDARD\r\nDTSTART:20000101T000000\r\n" +
+		"TZOFFSETFROM:+0000\r\nTZOFFSETTO:+0100\r\nRDATE:20001001T000000,20011001T000000\r\nEND:STANDARD\r\nBEGIN:DAYLIGHT\r\nDTSTART:20000601T000000\r\n" +
+		"TZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\nRDATE:20010601T000000,20020601T000000\r\nEND:DAYLIGHT\r\nEND:VTIMEZONE\r\nEND:VCALENDAR\r\n"
+	cal, err := Parse(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tz := cal.Timezone("Test")
+	for _, tt := range []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2000, time.March, 3600},
+		{2000, time.July, 7200},
+		{2000, time.December, 3600},
+		{2001, time.July, 7200},
+		{2001, time.December, 3600},
+		{2003, time.January, 7200},
+	} {
+		d := dt.DateTime{Date: dt.Date{Year: tt.year, Month: tt.month, Day: 1, Valid: true}, Time: dt.Time{Valid: true}}
+		if got, _ := tz.Offset(d); got != tt.want {
+			t.Errorf("Offset(%v): expected %d, got %d", d, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package dt
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"math/rand/v2"
+)
+
+// An Interval is an entry of an IntervalIndex: a range of time and the
+// value associated with it.
+type Interval[T comparable] struct {
+	Range DateTimeRange
+	Value T
+}
+
+// An IntervalIndex holds DateTime ranges with associated values and finds
+// the ranges overlapping a query. Insert and Delete take O(log n) expected
+// time, and a query returning k entries takes O((k+1) log n) expected time.
+// Ranges are half-open, so [09:00, 10:00) and [10:00, 11:00) do not overlap.
+//
+// The zero value is an empty index ready to use. An IntervalIndex must not
+// be modified while it is being iterated.
+type IntervalIndex[T comparable] struct {
+	root *intervalNode[T]
+	len  int
+}
+
+// An intervalNode is a node of a treap ordered by start and end, augmented
+// with the latest end within its subtree.
+type intervalNode[T comparable] struct {
+	Interval[T]
+	start, end  int // Unix minutes of the range.
+	maxEnd      int
+	priority    uint64
+	left, right *intervalNode[T]
+}
+
+// less reports whether n sorts before m.
+func (n *intervalNode[T]) less(m *intervalNode[T]) bool {
+	return n.start < m.start || (n.start == m.start && n.end < m.end)
+}
+
+// update recomputes maxEnd from n and its children.
+func (n *intervalNode[T]) update() *intervalNode[T] {
+	n.maxEnd = n.end
+	for _, c := range []*intervalNode[T]{n.left, n.right} {
+		if c != nil && c.maxEnd > n.maxEnd {
+			n.maxEnd = c.maxEnd
+		}
+	}
+	return n
+}
+
+// errEmptyInterval is returned when inserting a range that contains no time.
+var errEmptyInterval = errors.New("dt: interval must end after its start")
+
+// Insert adds the range r with value v to the index. The same range may be
+// inserted more than once. It returns an error if r is not valid or empty.
+func (x *IntervalIndex[T]) Insert(r DateTimeRange, v T) error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	if r.IsEmpty() {
+		return errEmptyInterval
+	}
+	n := &intervalNode[T]{
+		Interval: Interval[T]{r, v},
+		start:    r.Start.unixMinutes(),
+		end:      r.End.unixMinutes(),
+		priority: rand.Uint64(),
+	}
+	x.root = insertInterval(x.root, n.update())
+	x.len++
+	return nil
+}
+
+func insertInterval[T comparable](t, n *intervalNode[T]) *intervalNode[T] {
+	if t == nil {
+		return n
+	}
+	if n.priority > t.priority {
+		n.left, n.right = splitIntervals(t, n)
+		return n.update()
+	}
+	if n.less(t) {
+		t.left = insertInterval(t.left, n)
+	} else {
+		t.right = insertInterval(t.right, n)
+	}
+	return t.update()
+}
+
+// splitIntervals splits t into the nodes not sorting after n and the others.
+func splitIntervals[T comparable](t, n *intervalNode[T]) (*intervalNode[T], *intervalNode[T]) {
+	if t == nil {
+		return nil, nil
+	}
+	if !n.less(t) {
+		l, r := splitIntervals(t.right, n)
+		t.right = l
+		return t.update(), r
+	}
+	l, r := splitIntervals(t.left, n)
+	t.left = r
+	return l, t.update()
+}
+
+// mergeIntervals joins l and r, where all nodes of l sort before those of r.
+func mergeIntervals[T comparable](l, r *intervalNode[T]) *intervalNode[T] {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.priority > r.priority:
+		l.right = mergeIntervals(l.right, r)
+		return l.update()
+	}
+	r.left = mergeIntervals(l, r.left)
+	return r.update()
+}
+
+// Delete removes one entry with range r and value v from the index,
+// and reports whether there was one.
+func (x *IntervalIndex[T]) Delete(r DateTimeRange, v T) bool {
+	var deleted bool
+	key := &intervalNode[T]{start: r.Start.unixMinutes(), end: r.End.unixMinutes()}
+	x.root, deleted = deleteInterval(x.root, key, v)
+	if deleted {
+		x.len--
+	}
+	return deleted
+}
+
+func deleteInterval[T comparable](t, key *intervalNode[T], v T) (*intervalNode[T], bool) {
+	if t == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch {
+	case key.less(t):
+		t.left, deleted = deleteInterval(t.left, key, v)
+	case t.less(key):
+		t.right, deleted = deleteInterval(t.right, key, v)
+	case t.Value == v:
+		return mergeIntervals(t.left, t.right), true
+	default:
+		// Entries with the same range may be on either side.
+		if t.left, deleted = deleteInterval(t.left, key, v); !deleted {
+			t.right, deleted = deleteInterval(t.right, key, v)
+		}
+	}
+	return t.update(), deleted
+}
+
+// Len returns the number of entries in the index.
+func (x *IntervalIndex[T]) Len() int {
+	return x.len
+}
+
+// All returns the entries of the index ordered by start, then by end.
+// Entries with the same range are returned in the order they were inserted.
+func (x *IntervalIndex[T]) All() iter.Seq[Interval[T]] {
+	return x.search(math.MinInt, math.MaxInt)
+}
+
+// Overlapping returns the entries whose ranges overlap q, ordered by start,
+// then by end. An empty q overlaps nothing.
+func (x *IntervalIndex[T]) Overlapping(q DateTimeRange) iter.Seq[Interval[T]] {
+	if q.IsEmpty() {
+		return func(func(Interval[T]) bool) {}
+	}
+	return x.search(q.Start.unixMinutes(), q.End.unixMinutes())
+}
+
+// Stabbing returns the entries whose ranges contain dt, ordered by start,
+// then by end.
+func (x *IntervalIndex[T]) Stabbing(dt DateTime) iter.Seq[Interval[T]] {
+	m := dt.unixMinutes()
+	return x.search(m, m+1)
+}
+
+// search returns the entries overlapping the Unix minutes [start, end).
+func (x *IntervalIndex[T]) search(start, end int) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		var visited int
+		walkIntervals(x.root, start, end, yield, &visited)
+	}
+}
+
+// walkIntervals calls yield in order for the entries of t overlapping the Unix
+// minutes [start, end), and reports whether yield always returned true. It
+// adds the number of nodes visited to *visited.
+//
+// Subtrees whose entries all end by start are skipped by maxEnd, and right
+// subtrees of nodes starting at or after end by the order of the treap. A
+// visited node that is not yielded therefore either lies on the search path
+// of end, or has an overlapping entry in its subtree, so at most
+// O((k+1) log n) nodes are visited in expectation for k results.
+func walkIntervals[T comparable](n *intervalNode[T], start, end int, yield func(Interval[T]) bool, visited *int) bool {
+	if n == nil || n.maxEnd <= start {
+		return true
+	}
+	*visited++
+	if !walkIntervals(n.left, start, end, yield, visited) {
+		return false
+	}
+	if n.start >= end {
+		return true
+	}
+	if n.end > start && !yield(n.Interval) {
+		return false
+	}
+	return walkIntervals(n.right, start, end, yield, visited)
+}
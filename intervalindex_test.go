@@ -0,0 +1,201 @@
+package dt
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestIntervalIndex(t *testing.T) {
+	rng := func(s string) DateTimeRange {
+		t.Helper()
+		r, err := ParseDateTimeRange(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	var x IntervalIndex[string]
+	for _, b := range []struct {
+		r  string
+		id string
+	}{
+		{"2024-05-15T09:00/2024-05-15T10:00", "a"},
+		{"2024-05-15T10:00/2024-05-15T12:00", "b"},
+		{"2024-05-15T09:30/2024-05-16T09:00", "c"},
+		{"2024-05-14T22:00/2024-05-15T08:00", "d"},
+		{"2024-05-15T10:00/2024-05-15T12:00", "e"},
+	} {
+		if err := x.Insert(rng(b.r), b.id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := x.Insert(rng("2024-05-15T10:00/2024-05-15T10:00"), "empty"); err == nil {
+		t.Error("expected error inserting an empty range")
+	}
+	if err := x.Insert(DateTimeRange{}, "invalid"); err == nil {
+		t.Error("expected error inserting an invalid range")
+	}
+
+	values := func(seq func(func(Interval[string]) bool)) []string {
+		var vs []string
+		for iv := range seq {
+			vs = append(vs, iv.Value)
+		}
+		return vs
+	}
+	for _, tt := range []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"all", values(x.All()), []string{"d", "a", "c", "b", "e"}},
+		{"overlapping", values(x.Overlapping(rng("2024-05-15T08:00/2024-05-15T10:00"))), []string{"a", "c"}},
+		{"overlapping touching", values(x.Overlapping(rng("2024-05-15T12:00/2024-05-15T13:00"))), []string{"c"}},
+		{"overlapping empty", values(x.Overlapping(rng("2024-05-15T09:30/2024-05-15T09:30"))), nil},
+		{"stabbing", values(x.Stabbing(DateTime{Date{2024, time.May, 15, true}, Time{10, 0, true}})), []string{"c", "b", "e"}},
+		{"stabbing start", values(x.Stabbing(DateTime{Date{2024, time.May, 15, true}, Time{9, 0, true}})), []string{"a"}},
+		{"stabbing end", values(x.Stabbing(DateTime{Date{2024, time.May, 15, true}, Time{8, 0, true}})), nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if !slices.Equal(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if !x.Delete(rng("2024-05-15T10:00/2024-05-15T12:00"), "b") || x.Delete(rng("2024-05-15T10:00/2024-05-15T12:00"), "b") {
+		t.Error("expected to delete b exactly once")
+	}
+	if x.Delete(rng("2024-05-15T09:00/2024-05-15T10:00"), "c") {
+		t.Error("expected Delete to require a matching value")
+	}
+	if got := values(x.All()); x.Len() != 4 || !slices.Equal(got, []string{"d", "a", "c", "e"}) {
+		t.Errorf("after Delete: got %v with length %d", got, x.Len())
+	}
+	for iv := range x.All() {
+		if iv.Value == "a" {
+			break
+		}
+	}
+}
+
+func TestIntervalIndexMatchesScan(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	base := DateTime{Date{2024, time.January, 1, true}, Time{0, 0, true}}
+	at := func(m int) DateTime { return dateTimeFromUnixMinutes(base.unixMinutes() + m) }
+	random := func() DateTimeRange {
+		start := r.IntN(60 * 24 * 30)
+		return DateTimeRange{at(start), at(start + 1 + r.IntN(60*24))}
+	}
+	var x IntervalIndex[int]
+	var all []Interval[int]
+	for i := 0; i < 3000; i++ {
+		if len(all) > 0 && r.IntN(3) == 0 {
+			j := r.IntN(len(all))
+			if !x.Delete(all[j].Range, all[j].Value) {
+				t.Fatalf("Delete(%v, %d): not found", all[j].Range, all[j].Value)
+			}
+			all = slices.Delete(all, j, j+1)
+			continue
+		}
+		iv := Interval[int]{random(), r.IntN(50)}
+		if err := x.Insert(iv.Range, iv.Value); err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, iv)
+	}
+	if x.Len() != len(all) {
+		t.Fatalf("Len(): got %d, want %d", x.Len(), len(all))
+	}
+	byRange := func(a, b Interval[int]) int {
+		if c := a.Range.Start.Compare(b.Range.Start); c != 0 {
+			return c
+		}
+		return a.Range.End.Compare(b.Range.End)
+	}
+	order := func(a, b Interval[int]) int {
+		if c := byRange(a, b); c != 0 {
+			return c
+		}
+		return a.Value - b.Value
+	}
+	check := func(name string, got []Interval[int], keep func(Interval[int]) bool) {
+		t.Helper()
+		var want []Interval[int]
+		for _, iv := range all {
+			if keep(iv) {
+				want = append(want, iv)
+			}
+		}
+		if !slices.IsSortedFunc(got, byRange) {
+			t.Fatalf("%s: results are not ordered", name)
+		}
+		slices.SortFunc(got, order)
+		slices.SortFunc(want, order)
+		if !slices.Equal(got, want) {
+			t.Fatalf("%s: got %d entries, want %d", name, len(got), len(want))
+		}
+	}
+	for i := 0; i < 200; i++ {
+		q := random()
+		check("Overlapping("+q.String()+")", slices.Collect(x.Overlapping(q)), func(iv Interval[int]) bool { return iv.Range.Overlaps(q) })
+		p := q.Start
+		check("Stabbing("+p.String()+")", slices.Collect(x.Stabbing(p)), func(iv Interval[int]) bool { return iv.Range.Contains(p) })
+	}
+}
+
+func BenchmarkIntervalIndexOverlapping(b *testing.B) {
+	r := rand.New(rand.NewPCG(9, 10))
+	base := DateTime{Date{2024, time.January, 1, true}, Time{0, 0, true}}.unixMinutes()
+	var x IntervalIndex[int]
+	for i := 0; i < 50000; i++ {
+		start := base + r.IntN(60*24*365)
+		x.Insert(DateTimeRange{dateTimeFromUnixMinutes(start), dateTimeFromUnixMinutes(start + 60 + r.IntN(60*24*3))}, i)
+	}
+	q := DateTimeRange{dateTimeFromUnixMinutes(base + 60*24*180), dateTimeFromUnixMinutes(base + 60*24*181)}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range x.Overlapping(q) {
+		}
+	}
+}
+
+func TestIntervalIndexVisits(t *testing.T) {
+	const n = 1 << 14
+	base := DateTime{Date{2024, time.January, 1, true}, Time{0, 0, true}}
+	var x IntervalIndex[int]
+	// Consecutive hours, and one entry spanning all of them, so that maxEnd
+	// alone does not prune any subtree containing it.
+	for i := 0; i < n; i++ {
+		if err := x.Insert(DateTimeRange{dateTimeFromUnixMinutes(base.unixMinutes() + 60*i), dateTimeFromUnixMinutes(base.unixMinutes() + 60*i + 60)}, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := x.Insert(DateTimeRange{base, dateTimeFromUnixMinutes(base.unixMinutes() + 60*n)}, -1); err != nil {
+		t.Fatal(err)
+	}
+	logN := bits.Len(uint(x.Len()))
+	for _, q := range []struct{ start, end, k int }{
+		{0, 1, 2},
+		{60*n/2 + 30, 60*n/2 + 31, 2},
+		{60 * 1000, 60 * 1010, 11},
+		{60*n - 1, 60 * n, 2},
+		{60 * n, 60*n + 60, 0},
+		{-60, 0, 0},
+	} {
+		var k, visited int
+		start, end := base.unixMinutes()+q.start, base.unixMinutes()+q.end
+		walkIntervals(x.root, start, end, func(Interval[int]) bool { k++; return true }, &visited)
+		if k != q.k {
+			t.Errorf("[%d, %d): got %d results, want %d", q.start, q.end, k, q.k)
+		}
+		// The expected bound is (k+1) log n times a small constant; 4 leaves room
+		// for the variance of the treap's depth.
+		if limit := 4 * (k + 1) * logN; visited > limit {
+			t.Errorf("[%d, %d): visited %d nodes for %d results, want at most %d", q.start, q.end, visited, k, limit)
+		}
+	}
+}
@@ -0,0 +1,444 @@
+package dt
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Fields records which components of a value were given by the input to
+// ParseNatural, rather than taken from the reference.
+type Fields uint8
+
+// Components reported by ParseNatural.
+const (
+	YearField Fields = 1 << iota
+	MonthField
+	DayField
+	TimeField
+
+	DateFields = YearField | MonthField | DayField
+)
+
+// Has reports whether all components of g are set in f.
+func (f Fields) Has(g Fields) bool {
+	return f&g == g
+}
+
+// NaturalResult is the value of a natural-language expression.
+type NaturalResult struct {
+	// DateTime is the moment described by the input, or the first moment of
+	// Range. If the input gives no time of day, the time is midnight.
+	DateTime DateTime
+	// Range is Valid when the input describes a span of days, such as "next week".
+	Range DateRange
+	// Fields are the components given by the input.
+	Fields Fields
+}
+
+// IsRange reports whether the input described a span of days.
+func (r NaturalResult) IsRange() bool {
+	return r.Range.Start.Valid
+}
+
+// NaturalOptions configure ParseNatural.
+type NaturalOptions struct {
+	// Grammar holds the rules used to read the input. Defaults to EnglishGrammar.
+	Grammar Grammar
+	// DayFirst reads numeric dates such as 3/4 as day/month instead of month/day.
+	DayFirst bool
+	// WeekStart is the first day of the week for expressions such as "next week".
+	WeekStart time.Weekday
+}
+
+// A Grammar is a table of rules for reading natural-language dates.
+// At each position of the input, the rule with the longest match is applied;
+// ties go to the rule that comes first.
+type Grammar []NaturalRule
+
+// A NaturalRule recognizes one phrase, such as "tomorrow" or "in 2 weeks".
+type NaturalRule struct {
+	// Pattern matches the phrase. It is matched against lower-cased input and
+	// only counts if it matches at the current position and ends at a word
+	// boundary.
+	Pattern *regexp.Regexp
+	// Apply records the meaning of the phrase in s, given the submatches of Pattern.
+	// Errors are returned from ParseNatural as a *ParseError at the phrase.
+	Apply func(s *NaturalState, m []string) error
+}
+
+// NaturalState is the state of ParseNatural passed to rules.
+type NaturalState struct {
+	Ref     DateTime
+	Options NaturalOptions
+
+	date   Date
+	time   Time
+	rng    DateRange
+	fields Fields
+}
+
+var errConflict = errors.New("dt: conflicting date expressions")
+
+// SetDate records the date described by a phrase, along with the components
+// it gives. It returns an error if a date was already recorded.
+func (s *NaturalState) SetDate(d Date, f Fields) error {
+	if err := d.check(); err != nil {
+		return err
+	}
+	if s.date.Valid || s.rng.Start.Valid {
+		return errConflict
+	}
+	s.date, s.fields = d, s.fields|f
+	return nil
+}
+
+// SetTime records the time of day described by a phrase.
+// It returns an error if a time was already recorded.
+func (s *NaturalState) SetTime(t Time) error {
+	if err := t.check(); err != nil {
+		return err
+	}
+	if s.time.Valid {
+		return errConflict
+	}
+	s.time, s.fields = t, s.fields|TimeField
+	return nil
+}
+
+// SetRange records the span of days described by a phrase, along with the
+// components it gives. It returns an error if a date was already recorded.
+func (s *NaturalState) SetRange(r DateRange, f Fields) error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	if s.date.Valid || s.rng.Start.Valid {
+		return errConflict
+	}
+	s.rng, s.fields = r, s.fields|f
+	return nil
+}
+
+// ParseNatural reads a date, time or span of days written in natural
+// language, such as "next friday", "tomorrow 9am", "in 2 weeks",
+// "end of month" or "3/4", relative to ref. Missing components are taken
+// from ref, so "9am" is on ref's date and "3/4" in ref's year.
+// opts may be nil. Failures are reported as a *ParseError.
+func ParseNatural(input string, ref DateTime, opts *NaturalOptions) (NaturalResult, error) {
+	s := &NaturalState{Ref: ref}
+	if opts != nil {
+		s.Options = *opts
+	}
+	g := s.Options.Grammar
+	if g == nil {
+		g = EnglishGrammar
+	}
+	text := strings.ToLower(input)
+	fail := func(offset int, err error) (NaturalResult, error) {
+		pe := &ParseError{Type: "natural date", Input: input, Expected: []string{"a date, time or range"}, Offset: offset, Err: err}
+		var re *RangeError
+		if errors.As(err, &re) {
+			pe.Field = re.Field
+		}
+		return NaturalResult{}, pe
+	}
+
+	matched := false
+	for pos := 0; ; {
+		for pos < len(text) && (text[pos] == ' ' || text[pos] == ',' || text[pos] == '\t') {
+			pos++
+		}
+		if pos == len(text) {
+			break
+		}
+		rest := text[pos:]
+		var rule *NaturalRule
+		var loc []int
+		for i := range g {
+			l := g[i].Pattern.FindStringSubmatchIndex(rest)
+			if l == nil || l[0] != 0 || l[1] == 0 || !atBoundary(rest, l[1]) {
+				continue
+			}
+			if loc == nil || l[1] > loc[1] {
+				rule, loc = &g[i], l
+			}
+		}
+		if rule == nil {
+			return fail(pos, errors.New("dt: unrecognized expression"))
+		}
+		m := make([]string, len(loc)/2)
+		for i := range m {
+			if loc[2*i] >= 0 {
+				m[i] = rest[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		if err := rule.Apply(s, m); err != nil {
+			return fail(pos, err)
+		}
+		matched = true
+		pos += loc[1]
+	}
+	if !matched {
+		return fail(len(input), errors.New("dt: empty expression"))
+	}
+
+	r := NaturalResult{Range: s.rng, Fields: s.fields}
+	switch {
+	case s.rng.Start.Valid:
+		r.DateTime.Date = s.rng.Start
+	case s.date.Valid:
+		r.DateTime.Date = s.date
+	case s.time.Valid:
+		r.DateTime.Date = ref.Date
+	default:
+		return fail(len(input), errors.New("dt: no date or time in expression"))
+	}
+	r.DateTime.Time = s.time
+	if !s.time.Valid {
+		r.DateTime.Time = Time{Valid: true}
+	}
+	return r, nil
+}
+
+// atBoundary reports whether i in s is at the end of s or before a character
+// that cannot continue a word.
+func atBoundary(s string, i int) bool {
+	if i == len(s) {
+		return true
+	}
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+	next, _ := utf8.DecodeRuneInString(s[i:])
+	isWord := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+	return !isWord(prev) || !isWord(next)
+}
+
+// NumericGrammar holds rules for language-independent expressions:
+// ISO 8601 dates and date-times, numeric dates such as 3/4 and 3/4/2024,
+// and 24-hour times such as 14:30. It is included in EnglishGrammar, and
+// can be used as the basis of grammars for other languages.
+var NumericGrammar = Grammar{
+	rule(`(\d{4})-(\d{1,2})-(\d{1,2})(?:t(\d{1,2}):(\d{2}))?`, func(s *NaturalState, m []string) error {
+		if err := s.SetDate(Date{Year: atoi(m[1]), Month: time.Month(atoi(m[2])), Day: atoi(m[3]), Valid: true}, DateFields); err != nil {
+			return err
+		}
+		if m[4] != "" {
+			return s.SetTime(Time{Hour: atoi(m[4]), Minute: atoi(m[5]), Valid: true})
+		}
+		return nil
+	}),
+	rule(`(\d{1,2})/(\d{1,2})(?:/(\d{4}|\d{2}))?`, func(s *NaturalState, m []string) error {
+		month, day := atoi(m[1]), atoi(m[2])
+		if s.Options.DayFirst {
+			month, day = day, month
+		}
+		d := Date{Year: s.Ref.Date.Year, Month: time.Month(month), Day: day, Valid: true}
+		f := MonthField | DayField
+		if m[3] != "" {
+			d.Year, f = atoi(m[3]), DateFields
+			if len(m[3]) == 2 {
+				d.Year += 2000
+			}
+		}
+		return s.SetDate(d, f)
+	}),
+	rule(`(\d{1,2}):(\d{2})`, func(s *NaturalState, m []string) error {
+		return s.SetTime(Time{Hour: atoi(m[1]), Minute: atoi(m[2]), Valid: true})
+	}),
+}
+
+// EnglishGrammar reads English expressions such as "today", "next friday",
+// "last month", "end of next week", "in 2 weeks", "3 days ago", "march 4th",
+// "noon" and "9:30pm", in addition to those of NumericGrammar.
+var EnglishGrammar = slices.Concat(Grammar{
+	rule(`(?:at|on|the|of)`, func(*NaturalState, []string) error { return nil }),
+	rule(`now`, func(s *NaturalState, m []string) error {
+		if err := s.SetDate(s.Ref.Date, DateFields); err != nil {
+			return err
+		}
+		return s.SetTime(s.Ref.Time)
+	}),
+	rule(`(today|tonight|tomorrow|yesterday|(?:the )?day after tomorrow|(?:the )?day before yesterday)`, func(s *NaturalState, m []string) error {
+		days := map[string]int{"tomorrow": 1, "yesterday": -1}[m[1]]
+		if strings.HasSuffix(m[1], "after tomorrow") {
+			days = 2
+		} else if strings.HasSuffix(m[1], "before yesterday") {
+			days = -2
+		}
+		return s.SetDate(s.Ref.Date.AddDays(days), DateFields)
+	}),
+	rule(`(?:(next|last|this|on) )?`+weekdayPattern, func(s *NaturalState, m []string) error {
+		wd, ref := englishWeekdays[m[2][:3]], s.Ref.Date
+		switch m[1] {
+		case "next":
+			return s.SetDate(ref.NextWeekday(wd), DateFields)
+		case "last":
+			return s.SetDate(ref.PreviousWeekday(wd), DateFields)
+		}
+		return s.SetDate(ref.AddDays(-1).NextWeekday(wd), DateFields)
+	}),
+	rule(`(this|next|last) (week|month|quarter|year)`, func(s *NaturalState, m []string) error {
+		r, f := englishPeriod(s, m[1], m[2])
+		return s.SetRange(r, f)
+	}),
+	rule(`(?:(this|next|last) )?weekend`, func(s *NaturalState, m []string) error {
+		sat := s.Ref.Date.AddDays(-1).NextWeekday(time.Saturday)
+		if s.Ref.Date.Weekday() == time.Sunday {
+			sat = s.Ref.Date.AddDays(-1)
+		}
+		sat = sat.AddDays(7 * englishShift[m[1]])
+		return s.SetRange(DateRange{Start: sat, End: sat.AddDays(1)}, DateFields)
+	}),
+	rule(`(start|beginning|end) of (?:the )?(?:(this|next|last) )?(week|month|quarter|year)`, func(s *NaturalState, m []string) error {
+		r, _ := englishPeriod(s, m[2], m[3])
+		if m[1] == "end" {
+			return s.SetDate(r.End, DateFields)
+		}
+		return s.SetDate(r.Start, DateFields)
+	}),
+	rule(`in (`+numberPattern+`) `+unitPattern, func(s *NaturalState, m []string) error {
+		return englishOffset(s, englishNumber(m[1]), m[2])
+	}),
+	rule(`(`+numberPattern+`) `+unitPattern+` (ago|from now|later)`, func(s *NaturalState, m []string) error {
+		n := englishNumber(m[1])
+		if m[3] == "ago" {
+			n = -n
+		}
+		return englishOffset(s, n, m[2])
+	}),
+	rule(monthPattern+` (\d{1,2})(?:st|nd|rd|th)?(?:,? (\d{4}))?`, func(s *NaturalState, m []string) error {
+		return englishDate(s, m[3], englishMonths[m[1][:3]], m[2])
+	}),
+	rule(`(\d{1,2})(?:st|nd|rd|th)?(?: of)? `+monthPattern+`(?:,? (\d{4}))?`, func(s *NaturalState, m []string) error {
+		return englishDate(s, m[3], englishMonths[m[2][:3]], m[1])
+	}),
+	rule(monthPattern+`(?: (\d{4}))?`, func(s *NaturalState, m []string) error {
+		start, f := Date{Year: s.Ref.Date.Year, Month: englishMonths[m[1][:3]], Day: 1, Valid: true}, MonthField
+		if m[2] != "" {
+			start.Year, f = atoi(m[2]), YearField|MonthField
+		}
+		return s.SetRange(DateRange{Start: start, End: start.EndOfMonth()}, f)
+	}),
+	rule(`(\d{1,2})(?:st|nd|rd|th)`, func(s *NaturalState, m []string) error {
+		d := s.Ref.Date
+		d.Day = atoi(m[1])
+		return s.SetDate(d, DayField)
+	}),
+	rule(`(\d{1,2})(?::(\d{2}))? ?(am|pm|a\.m\.|p\.m\.)`, func(s *NaturalState, m []string) error {
+		hour := atoi(m[1])
+		if hour < 1 || hour > 12 {
+			return &RangeError{Field: "hour", Value: hour}
+		}
+		hour %= 12
+		if m[3][0] == 'p' {
+			hour += 12
+		}
+		return s.SetTime(Time{Hour: hour, Minute: atoi(m[2]), Valid: true})
+	}),
+	rule(`(noon|midday|midnight)`, func(s *NaturalState, m []string) error {
+		if m[1] == "midnight" {
+			return s.SetTime(Time{Valid: true})
+		}
+		return s.SetTime(Time{Hour: 12, Valid: true})
+	}),
+}, NumericGrammar)
+
+const (
+	weekdayPattern = `(sunday|sun|monday|mon|tuesday|tues|tue|wednesday|wed|thursday|thurs|thu|friday|fri|saturday|sat)`
+	monthPattern   = `(january|jan|february|feb|march|mar|april|apr|may|june|jun|july|jul|august|aug|september|sept|sep|october|oct|november|nov|december|dec)`
+	numberPattern  = `\d+|an?|one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve`
+	unitPattern    = `(minute|min|hour|hr|day|week|wk|month|year|yr)s?`
+)
+
+var (
+	englishWeekdays = map[string]time.Weekday{
+		"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+		"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+	}
+	englishMonths = map[string]time.Month{
+		"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+		"may": time.May, "jun": time.June, "jul": time.July, "aug": time.August,
+		"sep": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+	}
+	englishNumbers = map[string]int{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	}
+	englishShift = map[string]int{"next": 1, "last": -1}
+)
+
+func rule(pattern string, apply func(s *NaturalState, m []string) error) NaturalRule {
+	return NaturalRule{Pattern: regexp.MustCompile(`^(?:` + pattern + `)`), Apply: apply}
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func englishNumber(s string) int {
+	if n, ok := englishNumbers[s]; ok {
+		return n
+	}
+	return atoi(s)
+}
+
+// englishDate records the date of a written month, a day and an optional year.
+func englishDate(s *NaturalState, year string, month time.Month, day string) error {
+	d, f := Date{Year: s.Ref.Date.Year, Month: month, Day: atoi(day), Valid: true}, MonthField|DayField
+	if year != "" {
+		d.Year, f = atoi(year), DateFields
+	}
+	return s.SetDate(d, f)
+}
+
+// englishOffset records the moment n units after the reference.
+func englishOffset(s *NaturalState, n int, unit string) error {
+	ref := s.Ref.Date
+	switch unit {
+	case "minute", "min", "hour", "hr":
+		if unit[0] == 'h' {
+			n *= 60
+		}
+		d := addMinutes(s.Ref, n)
+		if err := s.SetDate(d.Date, DateFields); err != nil {
+			return err
+		}
+		return s.SetTime(d.Time)
+	case "day":
+		return s.SetDate(ref.AddDays(n), DateFields)
+	case "week", "wk":
+		return s.SetDate(ref.AddDays(7*n), DateFields)
+	case "month":
+		return s.SetDate(ref.AddMonths(n), DateFields)
+	}
+	return s.SetDate(ref.AddMonths(12*n), DateFields)
+}
+
+// englishPeriod returns the week, month, quarter or year around the
+// reference, shifted by "next" or "last", and the components it gives.
+func englishPeriod(s *NaturalState, shift, period string) (DateRange, Fields) {
+	ref, n := s.Ref.Date, englishShift[shift]
+	switch period {
+	case "week":
+		d := ref.AddDays(7 * n)
+		return DateRange{Start: d.StartOfWeek(s.Options.WeekStart), End: d.EndOfWeek(s.Options.WeekStart)}, DateFields
+	case "month":
+		d := ref.AddMonths(n)
+		return DateRange{Start: d.StartOfMonth(), End: d.EndOfMonth()}, YearField | MonthField
+	case "quarter":
+		d := ref.AddMonths(3 * n)
+		return DateRange{Start: d.StartOfQuarter(), End: d.EndOfQuarter()}, YearField | MonthField
+	}
+	d := ref.AddMonths(12 * n)
+	return DateRange{Start: d.StartOfYear(), End: d.EndOfYear()}, YearField
+}
+
+// addMinutes returns d moved by n minutes.
+func addMinutes(d DateTime, n int) DateTime {
+	return dateTimeFromUnixMinutes(d.unixMinutes() + n)
+}
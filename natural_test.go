@@ -0,0 +1,150 @@
+package dt
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestParseNatural(t *testing.T) {
+	// ref is Wednesday, 15 May 2024 at 10:00.
+	ref := DateTime{Date: Date{2024, time.May, 15, true}, Time: Time{10, 0, true}}
+	at := func(m time.Month, d, h, min int) DateTime {
+		return DateTime{Date: Date{2024, m, d, true}, Time: Time{h, min, true}}
+	}
+	days := func(m1 time.Month, d1 int, m2 time.Month, d2 int) DateRange {
+		return DateRange{Start: Date{2024, m1, d1, true}, End: Date{2024, m2, d2, true}}
+	}
+	cases := []struct {
+		input  string
+		opts   *NaturalOptions
+		want   DateTime
+		rng    DateRange
+		fields Fields
+	}{
+		{input: "today", want: at(time.May, 15, 0, 0), fields: DateFields},
+		{input: "tomorrow 9am", want: at(time.May, 16, 9, 0), fields: DateFields | TimeField},
+		{input: "9am tomorrow", want: at(time.May, 16, 9, 0), fields: DateFields | TimeField},
+		{input: "the day after tomorrow", want: at(time.May, 17, 0, 0), fields: DateFields},
+		{input: "now", want: ref, fields: DateFields | TimeField},
+		{input: "friday", want: at(time.May, 17, 0, 0), fields: DateFields},
+		{input: "next Friday", want: at(time.May, 17, 0, 0), fields: DateFields},
+		{input: "wednesday", want: at(time.May, 15, 0, 0), fields: DateFields},
+		{input: "next wed", want: at(time.May, 22, 0, 0), fields: DateFields},
+		{input: "last friday at 5:30 PM", want: at(time.May, 10, 17, 30), fields: DateFields | TimeField},
+		{input: "in 2 weeks", want: at(time.May, 29, 0, 0), fields: DateFields},
+		{input: "3 days ago", want: at(time.May, 12, 0, 0), fields: DateFields},
+		{input: "in an hour", want: at(time.May, 15, 11, 0), fields: DateFields | TimeField},
+		{input: "90 minutes from now", want: at(time.May, 15, 11, 30), fields: DateFields | TimeField},
+		{input: "in one month", want: at(time.June, 15, 0, 0), fields: DateFields},
+		{input: "end of month", want: at(time.May, 31, 0, 0), fields: DateFields},
+		{input: "end of the year", want: at(time.December, 31, 0, 0), fields: DateFields},
+		{input: "start of next week", opts: &NaturalOptions{WeekStart: time.Monday}, want: at(time.May, 20, 0, 0), fields: DateFields},
+		{input: "3/4", want: at(time.March, 4, 0, 0), fields: MonthField | DayField},
+		{input: "3/4", opts: &NaturalOptions{DayFirst: true}, want: at(time.April, 3, 0, 0), fields: MonthField | DayField},
+		{input: "3/4/25 14:30", want: DateTime{Date{2025, time.March, 4, true}, Time{14, 30, true}}, fields: DateFields | TimeField},
+		{input: "2024-06-01T14:30", want: at(time.June, 1, 14, 30), fields: DateFields | TimeField},
+		{input: "March 4th, 2025", want: DateTime{Date{2025, time.March, 4, true}, Time{0, 0, true}}, fields: DateFields},
+		{input: "4th of july", want: at(time.July, 4, 0, 0), fields: MonthField | DayField},
+		{input: "the 20th at noon", want: at(time.May, 20, 12, 0), fields: DayField | TimeField},
+		{input: "12am", want: at(time.May, 15, 0, 0), fields: TimeField},
+		{input: "noon", want: at(time.May, 15, 12, 0), fields: TimeField},
+		{
+			input:  "next week",
+			opts:   &NaturalOptions{WeekStart: time.Monday},
+			want:   at(time.May, 20, 0, 0),
+			rng:    days(time.May, 20, time.May, 26),
+			fields: DateFields,
+		},
+		{input: "this month", want: at(time.May, 1, 0, 0), rng: days(time.May, 1, time.May, 31), fields: YearField | MonthField},
+		{input: "last quarter", want: at(time.January, 1, 0, 0), rng: days(time.January, 1, time.March, 31), fields: YearField | MonthField},
+		{input: "june", want: at(time.June, 1, 0, 0), rng: days(time.June, 1, time.June, 30), fields: MonthField},
+		{input: "this weekend", want: at(time.May, 18, 0, 0), rng: days(time.May, 18, time.May, 19), fields: DateFields},
+	}
+	for _, tt := range cases {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseNatural(tt.input, ref, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.DateTime != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got.DateTime)
+			}
+			if got.Range != tt.rng || got.IsRange() != (tt.rng != DateRange{}) {
+				t.Errorf("expected range %v, got %v", tt.rng, got.Range)
+			}
+			if got.Fields != tt.fields {
+				t.Errorf("expected fields %04b, got %04b", tt.fields, got.Fields)
+			}
+		})
+	}
+}
+
+func TestParseNaturalErrors(t *testing.T) {
+	ref := DateTime{Date: Date{2024, time.May, 15, true}, Time: Time{10, 0, true}}
+	cases := []struct {
+		input  string
+		offset int
+		field  string
+	}{
+		{input: ""},
+		{input: "someday"},
+		{input: "tomorrow someday", offset: 9},
+		{input: "tomorrow friday", offset: 9},
+		{input: "2/30", field: "day"},
+		{input: "tomorrow 13pm", offset: 9, field: "hour"},
+		{input: "at", offset: 2},
+	}
+	for _, tt := range cases {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseNatural(tt.input, ref, nil)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected ParseError, got %v", err)
+			}
+			if pe.Offset != tt.offset || pe.Field != tt.field {
+				t.Errorf("expected offset %d and field %q, got %d and %q", tt.offset, tt.field, pe.Offset, pe.Field)
+			}
+		})
+	}
+}
+
+func TestNaturalGrammar(t *testing.T) {
+	ref := DateTime{Date: Date{2024, time.May, 15, true}, Time: Time{10, 0, true}}
+	german := append(Grammar{
+		{
+			Pattern: regexp.MustCompile(`^(heute|morgen|gestern)`),
+			Apply: func(s *NaturalState, m []string) error {
+				days := map[string]int{"morgen": 1, "gestern": -1}[m[1]]
+				return s.SetDate(s.Ref.Date.AddDays(days), DateFields)
+			},
+		},
+		{
+			Pattern: regexp.MustCompile(`^um`),
+			Apply:   func(*NaturalState, []string) error { return nil },
+		},
+	}, NumericGrammar...)
+	got, err := ParseNatural("Morgen um 9:15", ref, &NaturalOptions{Grammar: german})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (DateTime{Date{2024, time.May, 16, true}, Time{9, 15, true}}); got.DateTime != want {
+		t.Errorf("expected %v, got %v", want, got.DateTime)
+	}
+	if _, err := ParseNatural("tomorrow", ref, &NaturalOptions{Grammar: german}); err == nil {
+		t.Error("expected English phrase to be rejected by German grammar")
+	}
+}
+
+func TestNaturalGrammarAppend(t *testing.T) {
+	skip := func(*NaturalState, []string) error { return nil }
+	a := append(EnglishGrammar, NaturalRule{Pattern: regexp.MustCompile(`^a`), Apply: skip})
+	b := append(EnglishGrammar, NaturalRule{Pattern: regexp.MustCompile(`^b`), Apply: skip})
+	if got := a[len(a)-1].Pattern.String(); got != "^a" {
+		t.Errorf("grammars built from EnglishGrammar share storage: got rule %q", got)
+	}
+	if len(b) != len(EnglishGrammar)+1 {
+		t.Errorf("expected %d rules, got %d", len(EnglishGrammar)+1, len(b))
+	}
+}
@@ -0,0 +1,158 @@
+package dt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// An OffsetDateTime represents a date and time along with the fixed UTC offset
+// it was recorded at, such as 2024-01-01T10:30:00+02:00.
+//
+// Unlike DateTime, an OffsetDateTime describes a unique moment in time, while
+// still preserving the offset the value was originally expressed in.
+type OffsetDateTime struct {
+	DateTime DateTime
+	Offset   int // Offset in seconds east of UTC.
+}
+
+// OffsetDateTimeOf returns the OffsetDateTime of t, keeping the offset of t's location.
+func OffsetDateTimeOf(t time.Time) OffsetDateTime {
+	_, offset := t.Zone()
+	return OffsetDateTime{
+		DateTime: DateTimeOf(t),
+		Offset:   offset,
+	}
+}
+
+var odtFormats = []string{"2006-01-02T15:04:05Z07:00", "2006-01-02T15:04Z07:00", "2006-01-02 15:04:05Z07:00", "2006-01-02 15:04:05Z07"}
+
+// ParseOffsetDateTime parses a string in RFC3339 date-time format and returns the
+// OffsetDateTime it represents. Seconds and the fractional part are optional and
+// discarded, and the offset may be either 'Z' or ±hh:mm. The format PostgreSQL
+// uses for timestamptz columns, 2006-01-02 15:04:05+02, is accepted as well.
+// Failures are reported as a *ParseError.
+func ParseOffsetDateTime(s string) (OffsetDateTime, error) {
+	t, err := parseLayouts("offset datetime", s, odtFormats...)
+	if err != nil {
+		return OffsetDateTime{}, err
+	}
+	return OffsetDateTimeOf(t), nil
+}
+
+// IsValid reports whether the date and time of odt are valid.
+func (odt OffsetDateTime) IsValid() bool {
+	return odt.DateTime.IsValid()
+}
+
+// String returns odt in RFC3339 format, e.g. 2024-01-01T10:30:00+02:00.
+// If either the date or time is not Valid, it returns an empty string.
+func (odt OffsetDateTime) String() string {
+	if odt.DateTime.Date.Valid && odt.DateTime.Time.Valid {
+		return odt.ToTime().Format(time.RFC3339)
+	}
+	return ""
+}
+
+// ToTime returns the time.Time of odt, in a fixed zone of odt's offset.
+func (odt OffsetDateTime) ToTime() time.Time {
+	return odt.DateTime.In(time.FixedZone("", odt.Offset))
+}
+
+// UTC returns the same instant as odt, expressed with a zero offset.
+func (odt OffsetDateTime) UTC() OffsetDateTime {
+	return odt.WithOffset(0)
+}
+
+// WithOffset returns the same instant as odt, expressed at the given offset in seconds east of UTC.
+func (odt OffsetDateTime) WithOffset(offset int) OffsetDateTime {
+	r := OffsetDateTimeOf(odt.ToTime().In(time.FixedZone("", offset)))
+	r.DateTime.Date.Valid = odt.DateTime.Date.Valid
+	r.DateTime.Time.Valid = odt.DateTime.Time.Valid
+	return r
+}
+
+// In returns the time.Time of odt in the given location.
+//
+// In panics if loc is nil.
+func (odt OffsetDateTime) In(loc *time.Location) time.Time {
+	return odt.ToTime().In(loc)
+}
+
+// Before reports whether the instant odt occurs before odt2.
+func (odt OffsetDateTime) Before(odt2 OffsetDateTime) bool {
+	return odt.ToTime().Before(odt2.ToTime())
+}
+
+// After reports whether the instant odt occurs after odt2.
+func (odt OffsetDateTime) After(odt2 OffsetDateTime) bool {
+	return odt.ToTime().After(odt2.ToTime())
+}
+
+// Equal reports whether odt and odt2 represent the same instant,
+// even if they are expressed at different offsets.
+func (odt OffsetDateTime) Equal(odt2 OffsetDateTime) bool {
+	return odt.ToTime().Equal(odt2.ToTime())
+}
+
+// Compare compares the instants of odt and odt2. If odt is before odt2, it returns -1;
+// if odt is after odt2, it returns +1; otherwise it returns 0.
+func (odt OffsetDateTime) Compare(odt2 OffsetDateTime) int {
+	return odt.ToTime().Compare(odt2.ToTime())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of odt.String().
+func (odt OffsetDateTime) MarshalText() ([]byte, error) {
+	if err := odt.DateTime.check(); err != nil {
+		return nil, err
+	}
+	return []byte(odt.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The value is expected to be a string in a format accepted by ParseOffsetDateTime.
+func (odt *OffsetDateTime) UnmarshalText(data []byte) error {
+	var err error
+	*odt, err = ParseOffsetDateTime(string(data))
+	return err
+}
+
+// Value implements valuer interface
+func (odt OffsetDateTime) Value() (driver.Value, error) {
+	if err := odt.DateTime.check(); err != nil {
+		return nil, err
+	}
+	if odt.DateTime.Date.Valid && odt.DateTime.Time.Valid {
+		return driver.Value(odt.String()), nil
+	}
+	return nil, nil
+}
+
+// Scan implements sql scan interface
+func (odt *OffsetDateTime) Scan(value interface{}) error {
+	if value == nil {
+		*odt = OffsetDateTime{}
+		return nil
+	}
+
+	var str string
+	switch v := value.(type) {
+	case time.Time:
+		*odt = OffsetDateTimeOf(v)
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	default:
+		return fmt.Errorf("Can't convert %T to OffsetDateTime", value)
+	}
+
+	podt, err := ParseOffsetDateTime(str)
+	if err == nil {
+		*odt = podt
+	}
+
+	return err
+}
@@ -0,0 +1,231 @@
+package dt
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOffsetDateTimeOf(t *testing.T) {
+	tm := time.Date(2024, 1, 1, 10, 30, 0, 0, time.FixedZone("", 2*3600))
+	want := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 7200}
+	if got := OffsetDateTimeOf(tm); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestParseOffsetDateTime(t *testing.T) {
+	cases := []struct {
+		name    string
+		req     string
+		want    OffsetDateTime
+		wantErr bool
+	}{
+		{
+			name:    "Empty string",
+			wantErr: true,
+		},
+		{
+			name:    "Missing offset",
+			req:     "2024-01-01T10:30:00",
+			wantErr: true,
+		},
+		{
+			name: "UTC",
+			req:  "2024-01-01T10:30:00Z",
+			want: OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 0},
+		},
+		{
+			name: "Positive offset",
+			req:  "2024-01-01T10:30:00+02:00",
+			want: OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 7200},
+		},
+		{
+			name: "Negative offset without seconds",
+			req:  "2024-01-01T10:30-05:30",
+			want: OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, -19800},
+		},
+		{
+			name: "Fractional seconds",
+			req:  "2024-01-01T10:30:15.123+01:00",
+			want: OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 3600},
+		},
+		{
+			name: "PostgreSQL timestamptz",
+			req:  "2024-01-01 10:30:00+02",
+			want: OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 7200},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOffsetDateTime(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			var pe *ParseError
+			if err != nil && !errors.As(err, &pe) {
+				t.Errorf("expected ParseError, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOffsetDateTimeString(t *testing.T) {
+	cases := []struct {
+		name string
+		req  OffsetDateTime
+		want string
+	}{
+		{
+			name: "UTC",
+			req:  OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 0},
+			want: "2024-01-01T10:30:00Z",
+		},
+		{
+			name: "Negative offset",
+			req:  OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, -19800},
+			want: "2024-01-01T10:30:00-05:30",
+		},
+		{
+			name: "Invalid",
+			req:  OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{}}, 3600},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.req.String(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOffsetDateTimeConversion(t *testing.T) {
+	odt := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{1, 30, true}}, 7200}
+
+	want := time.Date(2023, 12, 31, 23, 30, 0, 0, time.UTC)
+	if got := odt.ToTime(); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if _, offset := odt.ToTime().Zone(); offset != 7200 {
+		t.Errorf("expected offset 7200, got %d", offset)
+	}
+
+	utc := OffsetDateTime{DateTime{Date{2023, 12, 31, true}, Time{23, 30, true}}, 0}
+	if got := odt.UTC(); got != utc {
+		t.Errorf("expected %v, got %v", utc, got)
+	}
+	if got := utc.WithOffset(7200); got != odt {
+		t.Errorf("expected %v, got %v", odt, got)
+	}
+}
+
+func TestOffsetDateTimeCompare(t *testing.T) {
+	a := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{12, 0, true}}, 7200}
+	b := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 0, true}}, 0}
+	c := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{11, 0, true}}, 0}
+
+	if !a.Equal(b) || a.Compare(b) != 0 {
+		t.Errorf("expected %v to equal %v", a, b)
+	}
+	if a == b {
+		t.Errorf("expected %v and %v to keep their offsets", a, b)
+	}
+	if !a.Before(c) || a.Compare(c) != -1 {
+		t.Errorf("expected %v to be before %v", a, c)
+	}
+	if !c.After(a) || c.Compare(a) != +1 {
+		t.Errorf("expected %v to be after %v", c, a)
+	}
+}
+
+type offsetDateTimeReq struct {
+	ODT OffsetDateTime `json:"odt"`
+}
+
+func TestMarshalOffsetDateTime(t *testing.T) {
+	req := offsetDateTimeReq{OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 7200}}
+	bts, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("expected success but got error: %v", err)
+	}
+	if exp := `{"odt":"2024-01-01T10:30:00+02:00"}`; exp != string(bts) {
+		t.Errorf("expected %s but got %s", exp, bts)
+	}
+
+	var got offsetDateTimeReq
+	if err := json.Unmarshal(bts, &got); err != nil {
+		t.Fatalf("expected success but got error: %v", err)
+	}
+	if got != req {
+		t.Errorf("expected %v, got %v", req, got)
+	}
+}
+
+func TestValueOffsetDateTime(t *testing.T) {
+	odt := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 7200}
+	val, err := odt.Value()
+	if err != nil || val != "2024-01-01T10:30:00+02:00" {
+		t.Errorf("unexpected value %v, error %v", val, err)
+	}
+
+	val, err = OffsetDateTime{}.Value()
+	if err != nil || val != nil {
+		t.Errorf("unexpected value %v, error %v", val, err)
+	}
+}
+
+func TestScanOffsetDateTime(t *testing.T) {
+	want := OffsetDateTime{DateTime{Date{2024, 1, 1, true}, Time{10, 30, true}}, 7200}
+	cases := []struct {
+		name    string
+		value   interface{}
+		want    OffsetDateTime
+		wantErr bool
+	}{
+		{
+			name: "Nil value",
+		},
+		{
+			name:  "Time value",
+			value: time.Date(2024, 1, 1, 10, 30, 0, 0, time.FixedZone("", 7200)),
+			want:  want,
+		},
+		{
+			name:  "Bytes value",
+			value: []byte("2024-01-01 10:30:00+02"),
+			want:  want,
+		},
+		{
+			name:  "String value",
+			value: "2024-01-01T10:30:00+02:00",
+			want:  want,
+		},
+		{
+			name:    "String error",
+			value:   "2024-01-01T10:30:00",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid type",
+			value:   8,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			odt := &OffsetDateTime{}
+			err := odt.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Error("expected error and got error do not match")
+			}
+			if *odt != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, *odt)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package dt
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// A PackedDate is a Date stored in 4 bytes instead of the 32 of Date, for
+// holding large numbers of dates in memory. It counts days from the Unix
+// epoch, offset by 2³¹ so that the zero value is a date that is not Valid
+// and packed dates sort in the same order as the dates they represent.
+//
+// PackedDate covers about 5.8 million years either side of 1970. Day
+// arithmetic and comparisons work on the day count directly. It supports the
+// same encodings as Date, to which it delegates.
+type PackedDate uint32
+
+// packedEpoch is the PackedDate of 1970-01-01.
+const packedEpoch = 1 << 31
+
+// PackDate returns d as a PackedDate. Dates that are not Valid pack to zero.
+// It returns a *RangeError if d holds an out-of-range field or is too far
+// from 1970 to be packed.
+func PackDate(d Date) (PackedDate, error) {
+	if !d.Valid {
+		return 0, nil
+	}
+	if err := d.check(); err != nil {
+		return 0, err
+	}
+	p, ok := packDays(int64(d.UnixDays()))
+	if !ok {
+		return 0, &RangeError{Field: "year", Value: d.Year}
+	}
+	return p, nil
+}
+
+// packDays returns the PackedDate n days after 1970-01-01, and whether it is
+// within range.
+func packDays(n int64) (PackedDate, bool) {
+	n += packedEpoch
+	if n <= 0 || n > math.MaxUint32 {
+		return 0, false
+	}
+	return PackedDate(n), true
+}
+
+// days returns the number of days between 1970-01-01 and p.
+func (p PackedDate) days() int {
+	return int(int64(p) - packedEpoch)
+}
+
+// Date returns the Date of p.
+func (p PackedDate) Date() Date {
+	if p == 0 {
+		return Date{}
+	}
+	return DateFromUnixDays(p.days())
+}
+
+// IsValid reports whether p holds a date.
+func (p PackedDate) IsValid() bool {
+	return p != 0
+}
+
+// String returns the date in RFC3339 full-date format, or an empty string if p is not valid.
+func (p PackedDate) String() string {
+	return p.Date().String()
+}
+
+// UnixDays returns the number of days between 1970-01-01 and p.
+func (p PackedDate) UnixDays() int {
+	return p.days()
+}
+
+// AddDays returns the date that is n days in the future, or in the past for
+// negative n. The result is not valid if p is not valid or the result is out of range.
+func (p PackedDate) AddDays(n int) PackedDate {
+	if p == 0 {
+		return 0
+	}
+	r, _ := packDays(int64(p.days()) + int64(n))
+	return r
+}
+
+// DaysSince returns the signed number of days between p and s.
+// This is the inverse operation to AddDays.
+func (p PackedDate) DaysSince(s PackedDate) int {
+	return int(int64(p) - int64(s))
+}
+
+// Weekday returns the day of the week of p.
+func (p PackedDate) Weekday() time.Weekday {
+	return weekdayFromDays(p.days())
+}
+
+// Before reports whether p occurs before p2.
+func (p PackedDate) Before(p2 PackedDate) bool {
+	return p < p2
+}
+
+// After reports whether p occurs after p2.
+func (p PackedDate) After(p2 PackedDate) bool {
+	return p > p2
+}
+
+// Compare compares p and p2. If p is before p2, it returns -1;
+// if p is after p2, it returns +1; otherwise it returns 0.
+// Packed dates that are not valid sort before all others.
+func (p PackedDate) Compare(p2 PackedDate) int {
+	switch {
+	case p < p2:
+		return -1
+	case p > p2:
+		return 1
+	}
+	return 0
+}
+
+// decode packs the Date decoded by f into p.
+func (p *PackedDate) decode(f func(d *Date) error) error {
+	var d Date
+	if err := f(&d); err != nil {
+		return err
+	}
+	packed, err := PackDate(d)
+	if err != nil {
+		return err
+	}
+	*p = packed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of p.String().
+func (p PackedDate) MarshalText() ([]byte, error) {
+	return p.Date().MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The date is expected to be a string in a format accepted by ParseDate.
+func (p *PackedDate) UnmarshalText(data []byte) error {
+	return p.decode(func(d *Date) error { return d.UnmarshalText(data) })
+}
+
+// Value implements the driver.Valuer interface.
+func (p PackedDate) Value() (driver.Value, error) {
+	return p.Date().Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *PackedDate) Scan(value interface{}) error {
+	switch value.(type) {
+	case nil, string, []byte:
+		return p.decode(func(d *Date) error { return d.Scan(value) })
+	}
+	return fmt.Errorf("Can't convert %T to PackedDate", value)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (p PackedDate) MarshalYAML() (interface{}, error) {
+	return p.Date().MarshalYAML()
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (p *PackedDate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return p.decode(func(d *Date) error { return d.UnmarshalYAML(unmarshal) })
+}
+
+// MarshalTOML implements the toml.Marshaler interface.
+func (p PackedDate) MarshalTOML() ([]byte, error) {
+	return p.Date().MarshalTOML()
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface.
+func (p *PackedDate) UnmarshalTOML(v interface{}) error {
+	return p.decode(func(d *Date) error { return d.UnmarshalTOML(v) })
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+func (p PackedDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return p.Date().MarshalXML(e, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (p *PackedDate) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return p.decode(func(d *Date) error { return d.UnmarshalXML(dec, start) })
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (p PackedDate) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return p.Date().MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (p *PackedDate) UnmarshalXMLAttr(attr xml.Attr) error {
+	return p.decode(func(d *Date) error { return d.UnmarshalXMLAttr(attr) })
+}
+
+// LogValue implements slog.LogValuer.
+func (p PackedDate) LogValue() slog.Value {
+	return p.Date().LogValue()
+}
+
+// Format implements fmt.Formatter, formatting p as its Date.
+func (p PackedDate) Format(f fmt.State, verb rune) {
+	p.Date().Format(f, verb)
+}
+
+// Set implements flag.Value, parsing s with ParseDate.
+func (p *PackedDate) Set(s string) error {
+	return p.decode(func(d *Date) error { return d.Set(s) })
+}
+
+// Type returns "date", implementing pflag.Value.
+func (*PackedDate) Type() string { return "date" }
@@ -0,0 +1,145 @@
+package dt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func TestPackDate(t *testing.T) {
+	if got := unsafe.Sizeof(PackedDate(0)); got != 4 {
+		t.Errorf("expected PackedDate to take 4 bytes, got %d", got)
+	}
+	for _, d := range []Date{
+		{1970, time.January, 1, true},
+		{2024, time.February, 29, true},
+		{1969, time.December, 31, true},
+		{-4713, time.November, 24, true},
+		{9999, time.December, 31, true},
+		{5_000_000, time.June, 30, true},
+		{},
+	} {
+		p, err := PackDate(d)
+		if err != nil {
+			t.Fatalf("PackDate(%v): unexpected error: %v", d, err)
+		}
+		if got := p.Date(); got != d {
+			t.Errorf("PackDate(%v).Date() = %v", d, got)
+		}
+		if p.IsValid() != d.Valid {
+			t.Errorf("PackDate(%v).IsValid() = %t", d, p.IsValid())
+		}
+		if d.Valid && p.Weekday() != d.Weekday() {
+			t.Errorf("PackDate(%v).Weekday() = %v, want %v", d, p.Weekday(), d.Weekday())
+		}
+	}
+
+	var rerr *RangeError
+	if _, err := PackDate(Date{2023, time.February, 29, true}); !errors.As(err, &rerr) || rerr.Field != "day" {
+		t.Errorf("expected day RangeError, got %v", err)
+	}
+	if _, err := PackDate(Date{7_000_000, time.January, 1, true}); !errors.As(err, &rerr) || rerr.Field != "year" {
+		t.Errorf("expected year RangeError, got %v", err)
+	}
+}
+
+func TestPackedDateArithmetic(t *testing.T) {
+	start := Date{1960, time.January, 1, true}
+	p, _ := PackDate(start)
+	for n := 0; n < 40000; n += 37 {
+		want := start.AddDays(n)
+		got := p.AddDays(n)
+		if got.Date() != want {
+			t.Fatalf("AddDays(%d) = %v, want %v", n, got, want)
+		}
+		if days := got.DaysSince(p); days != n {
+			t.Fatalf("DaysSince = %d, want %d", days, n)
+		}
+		if got.UnixDays() != want.UnixDays() {
+			t.Fatalf("UnixDays = %d, want %d", got.UnixDays(), want.UnixDays())
+		}
+	}
+	if got := PackedDate(0).AddDays(1); got.IsValid() {
+		t.Errorf("expected invalid date to stay invalid, got %v", got)
+	}
+	if got := PackedDate(1).AddDays(-1); got.IsValid() {
+		t.Errorf("expected out-of-range result to be invalid, got %v", got)
+	}
+
+	dates := []Date{{2024, 5, 15, true}, {}, {1900, 1, 1, true}, {2024, 5, 14, true}}
+	packed := make([]PackedDate, len(dates))
+	for i, d := range dates {
+		packed[i], _ = PackDate(d)
+	}
+	slices.SortFunc(packed, PackedDate.Compare)
+	want := []string{"", "1900-01-01", "2024-05-14", "2024-05-15"}
+	for i, p := range packed {
+		if p.String() != want[i] {
+			t.Errorf("sorted[%d] = %v, want %s", i, p, want[i])
+		}
+	}
+	if !packed[2].Before(packed[3]) || !packed[3].After(packed[2]) || packed[2].Compare(packed[2]) != 0 {
+		t.Error("unexpected comparison results")
+	}
+}
+
+func TestPackedDateEncoding(t *testing.T) {
+	type doc struct {
+		D PackedDate `json:"d"`
+		E PackedDate `json:"e"`
+	}
+	p, _ := PackDate(Date{2024, time.May, 15, true})
+	in := doc{D: p}
+
+	b, err := json.Marshal(in)
+	if err != nil || string(b) != `{"d":"2024-05-15","e":""}` {
+		t.Errorf("unexpected JSON %s (error %v)", b, err)
+	}
+	var out doc
+	if err := json.Unmarshal([]byte(`{"d":"2024-05-15"}`), &out); err != nil || out != in {
+		t.Errorf("expected %v, got %v (error %v)", in, out, err)
+	}
+
+	if v, err := p.Value(); err != nil || v != "2024-05-15" {
+		t.Errorf("unexpected Value %v (error %v)", v, err)
+	}
+	var s PackedDate
+	for _, tt := range []struct {
+		value   interface{}
+		want    PackedDate
+		wantErr bool
+	}{
+		{"2024-05-15", p, false},
+		{[]byte("2024-05-15"), p, false},
+		{nil, 0, false},
+		{12, 0, true},
+		{"2024-13-01", 0, true},
+	} {
+		s = 0
+		if err := s.Scan(tt.value); (err != nil) != tt.wantErr || s != tt.want {
+			t.Errorf("Scan(%v) = %v (error %v), want %v", tt.value, s, err, tt.want)
+		}
+	}
+	if got := fmt.Sprintf("%v|%+v", p, PackedDate(0)); got != "2024-05-15|0000-00-00 (invalid)" {
+		t.Errorf("unexpected formatting %q", got)
+	}
+}
+
+func BenchmarkAddDays(b *testing.B) {
+	d := Date{2024, time.May, 15, true}
+	p, _ := PackDate(d)
+	b.Run("Date", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.AddDays(i % 1000)
+		}
+	})
+	b.Run("PackedDate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p.AddDays(i % 1000)
+		}
+	})
+}
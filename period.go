@@ -0,0 +1,142 @@
+package dt
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A Period is an amount of calendar time in years, months and days, such as
+// the ISO 8601 duration P1Y2M10D. Unlike time.Duration, the length of a
+// Period depends on the date it is added to.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+var periodExpected = []string{"PnYnMnD", "PnW"}
+
+// ParsePeriod parses an ISO 8601 duration made of years, months, weeks and
+// days, such as P1Y6M, P2W or P10D, and returns the Period it represents.
+// Weeks are converted to 7 days. A leading minus sign negates all components,
+// and components may have their own sign, as in P1M-1D.
+// Failures are reported as a *ParseError.
+func ParsePeriod(s string) (Period, error) {
+	perr := func(offset int, field string) (Period, error) {
+		return Period{}, &ParseError{Type: "period", Input: s, Expected: periodExpected, Offset: offset, Field: field}
+	}
+	rest, neg := strings.CutPrefix(s, "-")
+	if !neg {
+		rest, _ = strings.CutPrefix(rest, "+")
+	}
+	i := len(s) - len(rest)
+	if !strings.HasPrefix(rest, "P") {
+		return perr(i, "")
+	}
+	i++
+	var p Period
+	next := 0 // Index in "YMWD" of the first designator allowed next.
+	for i < len(s) {
+		start := i
+		if s[i] == '-' || s[i] == '+' {
+			i++
+		}
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == len(s) || i == start || (i == start+1 && (s[start] == '-' || s[start] == '+')) {
+			return perr(i, "")
+		}
+		k := strings.IndexByte("YMWD", s[i])
+		if k < next {
+			return perr(i, "")
+		}
+		field := [...]string{"year", "month", "week", "day"}[k]
+		n, err := strconv.Atoi(s[start:i])
+		if err != nil || (k == 2 && (n > math.MaxInt/7 || n < -math.MaxInt/7)) {
+			return perr(start, field)
+		}
+		switch k {
+		case 0:
+			p.Years = n
+		case 1:
+			p.Months = n
+		case 2:
+			p.Days = 7 * n
+		case 3:
+			if p.Days, err = addInt(p.Days, n); err != nil {
+				return perr(start, field)
+			}
+		}
+		next = k + 1
+		i++
+	}
+	if next == 0 {
+		return perr(i, "")
+	}
+	if neg {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// addInt returns a+b, or an error if the sum overflows.
+func addInt(a, b int) (int, error) {
+	if (b > 0 && a > math.MaxInt-b) || (b < 0 && a < -math.MaxInt-b) {
+		return 0, strconv.ErrRange
+	}
+	return a + b, nil
+}
+
+// String returns p in the ISO 8601 duration format, e.g. P1Y2M10D. The zero
+// Period is P0D, and a Period without positive components starts with a
+// minus sign, as in -P1M.
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	if p.Years <= 0 && p.Months <= 0 && p.Days <= 0 {
+		return "-" + p.Negate().String()
+	}
+	b := []byte{'P'}
+	for _, c := range []struct {
+		n      int
+		suffix byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Days, 'D'}} {
+		if c.n != 0 {
+			b = append(strconv.AppendInt(b, int64(c.n), 10), c.suffix)
+		}
+	}
+	return string(b)
+}
+
+// IsZero reports whether p has no years, months or days.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns p with the sign of each component reversed.
+func (p Period) Negate() Period {
+	return Period{-p.Years, -p.Months, -p.Days}
+}
+
+// AddPeriod returns d moved by p: first by its years and months, as with
+// AddMonths, and then by its days.
+func (d Date) AddPeriod(p Period) Date {
+	return d.AddMonths(12*p.Years + p.Months).AddDays(p.Days)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of p.String().
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The period is expected to be a string in a format accepted by ParsePeriod.
+func (p *Period) UnmarshalText(data []byte) error {
+	var err error
+	*p, err = ParsePeriod(string(data))
+	return err
+}
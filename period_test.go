@@ -0,0 +1,99 @@
+package dt
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want Period
+		str  string
+	}{
+		{"P1Y2M10D", Period{1, 2, 10}, "P1Y2M10D"},
+		{"P6M", Period{Months: 6}, "P6M"},
+		{"P2W", Period{Days: 14}, "P14D"},
+		{"P1W3D", Period{Days: 10}, "P10D"},
+		{"P0D", Period{}, "P0D"},
+		{"-P1Y1D", Period{-1, 0, -1}, "-P1Y1D"},
+		{"+P3D", Period{Days: 3}, "P3D"},
+		{"P1M-1D", Period{Months: 1, Days: -1}, "P1M-1D"},
+		{"-P1M-1D", Period{Months: -1, Days: 1}, "P-1M1D"},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParsePeriod(tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+			if s := got.String(); s != tt.str {
+				t.Errorf("expected String() %q, got %q", tt.str, s)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		in     string
+		offset int
+		field  string
+	}{
+		{"", 0, ""},
+		{"1D", 0, ""},
+		{"P", 1, ""},
+		{"PD", 1, ""},
+		{"P1", 2, ""},
+		{"P-D", 2, ""},
+		{"P1D1M", 4, ""},
+		{"P1Y1Y", 4, ""},
+		{"P1DT2H", 3, ""},
+		{"P1.5D", 2, ""},
+		{"P99999999999999999999Y", 1, "year"},
+		{"P9223372036854775807W", 1, "week"},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := ParsePeriod(tt.in)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected ParseError, got %v", err)
+			}
+			if pe.Type != "period" || pe.Offset != tt.offset || pe.Field != tt.field {
+				t.Errorf("expected offset %d and field %q, got %+v", tt.offset, tt.field, pe)
+			}
+		})
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		d    Date
+		p    Period
+		want Date
+	}{
+		{Date{2024, time.January, 31, true}, Period{Months: 1}, Date{2024, time.February, 29, true}},
+		{Date{2024, time.February, 29, true}, Period{Years: 1}, Date{2025, time.February, 28, true}},
+		{Date{2024, time.January, 31, true}, Period{Months: 1, Days: 1}, Date{2024, time.March, 1, true}},
+		{Date{2024, time.March, 31, true}, Period{Months: -1, Days: -1}, Date{2024, time.February, 28, true}},
+		{Date{2024, time.May, 15, true}, Period{}, Date{2024, time.May, 15, true}},
+	} {
+		if got := tt.d.AddPeriod(tt.p); got != tt.want {
+			t.Errorf("%v.AddPeriod(%v): expected %v, got %v", tt.d, tt.p, tt.want, got)
+		}
+	}
+}
+
+func TestPeriodText(t *testing.T) {
+	var p Period
+	if err := p.UnmarshalText([]byte("P1Y2D")); err != nil || p != (Period{Years: 1, Days: 2}) {
+		t.Errorf("unexpected result %+v (error %v)", p, err)
+	}
+	if b, err := p.MarshalText(); err != nil || string(b) != "P1Y2D" {
+		t.Errorf("unexpected result %s (error %v)", b, err)
+	}
+	if err := p.UnmarshalText([]byte("P1H")); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,338 @@
+package dt
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Frequency is the period at which a Recurrence repeats.
+type Frequency int
+
+// Frequencies of a Recurrence.
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = [...]string{"DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+// String returns the RFC 5545 name of f, e.g. "WEEKLY".
+func (f Frequency) String() string {
+	if f < Daily || f > Yearly {
+		return "Frequency(" + strconv.Itoa(int(f)) + ")"
+	}
+	return frequencyNames[f]
+}
+
+// A WeekdayNum selects a day of the week, optionally limited to its n-th
+// occurrence within the month or year, as in the RFC 5545 BYDAY values
+// MO, 1MO and -1FR.
+type WeekdayNum struct {
+	N       int // Occurrence, counting from the end if negative; 0 for every occurrence.
+	Weekday time.Weekday
+}
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// String returns w in RFC 5545 BYDAY format, e.g. "-1FR".
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayCodes[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+}
+
+// A Recurrence is a rule for repeating dates, covering the date-based subset
+// of RFC 5545 recurrence rules: FREQ, INTERVAL, COUNT, UNTIL, BYMONTH,
+// BYMONTHDAY, BYDAY and WKST.
+//
+// Rule parts that are not set default to the corresponding part of the start
+// date, so a monthly rule without BYDAY or BYMONTHDAY repeats on the start's
+// day of the month, skipping months that do not have it.
+type Recurrence struct {
+	Freq       Frequency
+	Interval   int  // Number of periods between repetitions; 0 is treated as 1.
+	Count      int  // Number of dates to produce; 0 for no limit.
+	Until      Date // Last date that may be produced; not Valid for no limit.
+	ByMonth    []time.Month
+	ByMonthDay []int // Days of the month, counting from the end if negative.
+	ByDay      []WeekdayNum
+	WeekStart  time.Weekday // First day of the week for weekly rules.
+}
+
+// ParseRecurrence parses an RFC 5545 RRULE value such as
+// "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", optionally prefixed with "RRULE:".
+// WKST defaults to Monday. The time of day of an UNTIL date-time is discarded.
+// Failures are reported as a *ParseError.
+func ParseRecurrence(s string) (Recurrence, error) {
+	r := Recurrence{Freq: -1, WeekStart: time.Monday}
+	offset := 0
+	rest := s
+	if strings.HasPrefix(strings.ToUpper(rest), "RRULE:") {
+		rest, offset = rest[len("RRULE:"):], len("RRULE:")
+	}
+	fail := func(offset int, format string, args ...interface{}) (Recurrence, error) {
+		return Recurrence{}, &ParseError{Type: "recurrence rule", Input: s, Expected: []string{"RFC 5545 RRULE"}, Offset: offset, Err: fmt.Errorf(format, args...)}
+	}
+	for _, part := range strings.Split(rest, ";") {
+		name, value, _ := strings.Cut(strings.ToUpper(part), "=")
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq = -1
+			for i, n := range frequencyNames {
+				if n == value {
+					r.Freq = Frequency(i)
+				}
+			}
+			if r.Freq < 0 {
+				err = fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = positive(value)
+		case "COUNT":
+			r.Count, err = positive(value)
+		case "UNTIL":
+			var t time.Time
+			if t, err = time.Parse("20060102", value[:min(len(value), 8)]); err == nil {
+				r.Until = DateOf(t)
+			}
+		case "BYMONTH":
+			err = eachValue(value, func(v string) error {
+				m, err := strconv.Atoi(v)
+				if m < 1 || m > 12 {
+					return fmt.Errorf("month %q out of range", v)
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+				return err
+			})
+		case "BYMONTHDAY":
+			err = eachValue(value, func(v string) error {
+				d, err := strconv.Atoi(v)
+				if d == 0 || d < -31 || d > 31 {
+					return fmt.Errorf("day %q out of range", v)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, d)
+				return err
+			})
+		case "BYDAY":
+			err = eachValue(value, func(v string) error {
+				wd, ok := weekdayCode(v[max(len(v)-2, 0):])
+				if !ok {
+					return fmt.Errorf("unknown weekday %q", v)
+				}
+				w := WeekdayNum{Weekday: wd}
+				if num := v[:len(v)-2]; num != "" {
+					n, err := strconv.Atoi(num)
+					if err != nil || n == 0 || n < -53 || n > 53 {
+						return fmt.Errorf("occurrence %q out of range", num)
+					}
+					w.N = n
+				}
+				r.ByDay = append(r.ByDay, w)
+				return nil
+			})
+		case "WKST":
+			var ok bool
+			if r.WeekStart, ok = weekdayCode(value); !ok {
+				err = fmt.Errorf("unknown weekday %q", value)
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part %q", name)
+		}
+		if err != nil {
+			return fail(offset, "dt: %w", err)
+		}
+		offset += len(part) + 1
+	}
+	if r.Freq < 0 {
+		return fail(len(s), "dt: missing FREQ")
+	}
+	return r, nil
+}
+
+func positive(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err == nil && n < 1 {
+		err = fmt.Errorf("%d is not positive", n)
+	}
+	return n, err
+}
+
+func eachValue(s string, f func(string) error) error {
+	for _, v := range strings.Split(s, ",") {
+		if err := f(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func weekdayCode(s string) (time.Weekday, bool) {
+	for i, c := range weekdayCodes {
+		if c == s {
+			return time.Weekday(i), true
+		}
+	}
+	return 0, false
+}
+
+// String returns r as an RFC 5545 RRULE value, without the "RRULE:" prefix.
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until.Valid {
+		parts = append(parts, fmt.Sprintf("UNTIL=%04d%02d%02d", r.Until.Year, r.Until.Month, r.Until.Day))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinValues(r.ByMonth, func(m time.Month) string { return strconv.Itoa(int(m)) }))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinValues(r.ByMonthDay, strconv.Itoa))
+	}
+	if len(r.ByDay) > 0 {
+		parts = append(parts, "BYDAY="+joinValues(r.ByDay, WeekdayNum.String))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinValues[T any](vs []T, f func(T) string) string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = f(v)
+	}
+	return strings.Join(s, ",")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of r.String().
+func (r Recurrence) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The rule is expected to be in a format accepted by ParseRecurrence.
+func (r *Recurrence) UnmarshalText(data []byte) error {
+	var err error
+	*r, err = ParseRecurrence(string(data))
+	return err
+}
+
+// Dates returns the dates produced by r from start, in order. Like DTSTART in
+// RFC 5545, start is the first date when it matches the rule. The sequence
+// ends after Count dates, after Until, or at the end of year 9999.
+func (r Recurrence) Dates(start Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if !start.IsValid() {
+			return
+		}
+		interval := max(r.Interval, 1)
+		n := 0
+		for i := 0; ; i += interval {
+			first, last := r.period(start, i)
+			if first.Year > 9999 || (r.Until.Valid && r.Until.Before(first)) {
+				return
+			}
+			for d := first; !d.After(last); d = d.AddDays(1) {
+				if d.Before(start) || !r.matches(d, start) {
+					continue
+				}
+				if r.Until.Valid && d.After(r.Until) {
+					return
+				}
+				if !yield(d) {
+					return
+				}
+				if n++; r.Count > 0 && n >= r.Count {
+					return
+				}
+			}
+		}
+	}
+}
+
+// period returns the first and last day of the i-th period from start.
+func (r Recurrence) period(start Date, i int) (Date, Date) {
+	switch r.Freq {
+	case Weekly:
+		first := start.StartOfWeek(r.WeekStart).AddDays(7 * i)
+		return first, first.AddDays(6)
+	case Monthly:
+		first := start.StartOfMonth().AddMonths(i)
+		return first, first.EndOfMonth()
+	case Yearly:
+		first := start.StartOfYear().AddYears(i)
+		return first, first.EndOfYear()
+	}
+	d := start.AddDays(i)
+	return d, d
+}
+
+// matches reports whether d satisfies the BY rule parts of r, or the parts of
+// start that stand in for missing ones.
+func (r Recurrence) matches(d, start Date) bool {
+	yearly, monthly := r.Freq == Yearly, r.Freq == Monthly
+	switch {
+	case len(r.ByMonth) > 0:
+		if !contains(r.ByMonth, d.Month) {
+			return false
+		}
+	case yearly && len(r.ByDay) == 0 && len(r.ByMonthDay) == 0:
+		if d.Month != start.Month {
+			return false
+		}
+	}
+	switch {
+	case len(r.ByMonthDay) > 0:
+		if !contains(r.ByMonthDay, d.Day) && !contains(r.ByMonthDay, d.Day-d.DaysInMonth()-1) {
+			return false
+		}
+	case (monthly || yearly) && len(r.ByDay) == 0:
+		if d.Day != start.Day {
+			return false
+		}
+	}
+	switch {
+	case len(r.ByDay) > 0:
+		// Occurrences count within the month, except for yearly rules
+		// without BYMONTH, where they count within the year.
+		pos, length := d.Day-1, d.DaysInMonth()
+		if yearly && len(r.ByMonth) == 0 {
+			pos, length = d.UnixDays()-d.StartOfYear().UnixDays(), d.EndOfYear().UnixDays()-d.StartOfYear().UnixDays()+1
+		}
+		for _, w := range r.ByDay {
+			if w.Weekday != d.Weekday() {
+				continue
+			}
+			if w.N == 0 || r.Freq <= Weekly || w.N == pos/7+1 || w.N == -((length-1-pos)/7+1) {
+				return true
+			}
+		}
+		return false
+	case r.Freq == Weekly:
+		return d.Weekday() == start.Weekday()
+	}
+	return true
+}
+
+func contains[T comparable](vs []T, v T) bool {
+	for _, x := range vs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package dt
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	cases := []struct {
+		rule    string
+		want    Recurrence
+		str     string
+		wantErr bool
+	}{
+		{
+			rule: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			want: Recurrence{Freq: Weekly, Interval: 2, ByDay: []WeekdayNum{{0, time.Monday}, {0, time.Wednesday}}, WeekStart: time.Monday},
+			str:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+		},
+		{
+			rule: "freq=monthly;byday=-1fr;count=3;wkst=su",
+			want: Recurrence{Freq: Monthly, Count: 3, ByDay: []WeekdayNum{{-1, time.Friday}}, WeekStart: time.Sunday},
+			str:  "FREQ=MONTHLY;COUNT=3;BYDAY=-1FR;WKST=SU",
+		},
+		{
+			rule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1;UNTIL=20301231T235959Z",
+			want: Recurrence{Freq: Yearly, Until: Date{2030, 12, 31, true}, ByMonth: []time.Month{time.February}, ByMonthDay: []int{-1}, WeekStart: time.Monday},
+			str:  "FREQ=YEARLY;UNTIL=20301231;BYMONTH=2;BYMONTHDAY=-1",
+		},
+		{rule: "INTERVAL=2", wantErr: true},
+		{rule: "FREQ=HOURLY", wantErr: true},
+		{rule: "FREQ=DAILY;BYDAY=XX", wantErr: true},
+		{rule: "FREQ=DAILY;BYMONTH=13", wantErr: true},
+		{rule: "FREQ=DAILY;BYSETPOS=1", wantErr: true},
+		{rule: "FREQ=DAILY;COUNT=0", wantErr: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := ParseRecurrence(tt.rule)
+			if tt.wantErr {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Errorf("expected ParseError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+			if s := got.String(); s != tt.str {
+				t.Errorf("expected %s, got %s", tt.str, s)
+			}
+		})
+	}
+}
+
+func TestRecurrenceDates(t *testing.T) {
+	d := func(y int, m time.Month, day int) Date { return Date{y, m, day, true} }
+	cases := []struct {
+		name  string
+		rule  string
+		start Date
+		limit int
+		want  []Date
+	}{
+		{
+			name:  "Daily with interval and count",
+			rule:  "FREQ=DAILY;INTERVAL=10;COUNT=3",
+			start: d(2024, time.February, 20),
+			want:  []Date{d(2024, time.February, 20), d(2024, time.March, 1), d(2024, time.March, 11)},
+		},
+		{
+			name:  "Weekdays only",
+			rule:  "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start: d(2024, time.May, 16),
+			limit: 4,
+			want:  []Date{d(2024, time.May, 16), d(2024, time.May, 17), d(2024, time.May, 20), d(2024, time.May, 21)},
+		},
+		{
+			name:  "Weekly on the start's weekday until",
+			rule:  "FREQ=WEEKLY;UNTIL=20240605",
+			start: d(2024, time.May, 15),
+			want:  []Date{d(2024, time.May, 15), d(2024, time.May, 22), d(2024, time.May, 29), d(2024, time.June, 5)},
+		},
+		{
+			name:  "Every other week on two days",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=4",
+			start: d(2024, time.May, 15),
+			want:  []Date{d(2024, time.May, 16), d(2024, time.May, 28), d(2024, time.May, 30), d(2024, time.June, 11)},
+		},
+		{
+			name:  "Monthly on the 31st skips short months",
+			rule:  "FREQ=MONTHLY;COUNT=3",
+			start: d(2024, time.January, 31),
+			want:  []Date{d(2024, time.January, 31), d(2024, time.March, 31), d(2024, time.May, 31)},
+		},
+		{
+			name:  "Last Friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			start: d(2024, time.January, 1),
+			want:  []Date{d(2024, time.January, 26), d(2024, time.February, 23), d(2024, time.March, 29)},
+		},
+		{
+			name:  "Last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			start: d(2024, time.January, 1),
+			want:  []Date{d(2024, time.January, 31), d(2024, time.February, 29), d(2024, time.March, 31)},
+		},
+		{
+			name:  "Yearly on a leap day",
+			rule:  "FREQ=YEARLY;COUNT=2",
+			start: d(2024, time.February, 29),
+			want:  []Date{d(2024, time.February, 29), d(2028, time.February, 29)},
+		},
+		{
+			name:  "US Thanksgiving",
+			rule:  "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=3",
+			start: d(2024, time.January, 1),
+			want:  []Date{d(2024, time.November, 28), d(2025, time.November, 27), d(2026, time.November, 26)},
+		},
+		{
+			name:  "Twentieth Monday of the year",
+			rule:  "FREQ=YEARLY;BYDAY=20MO;COUNT=2",
+			start: d(2024, time.January, 1),
+			want:  []Date{d(2024, time.May, 13), d(2025, time.May, 19)},
+		},
+		{
+			name:  "Never matching rule ends",
+			rule:  "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			start: d(9990, time.January, 1),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []Date
+			for d := range r.Dates(tt.start) {
+				got = append(got, d)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
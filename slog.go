@@ -0,0 +1,68 @@
+package dt
+
+import "log/slog"
+
+// logValue returns s as a slog string value, or a nil value if valid is false,
+// so that JSON handlers write null.
+func logValue(s string, valid bool) slog.Value {
+	if !valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(s)
+}
+
+// LogValue implements slog.LogValuer, logging d as its String or null if d is not Valid.
+func (d Date) LogValue() slog.Value { return logValue(d.String(), d.Valid) }
+
+// LogValue implements slog.LogValuer, logging t as its String or null if t is not Valid.
+func (t Time) LogValue() slog.Value { return logValue(t.String(), t.Valid) }
+
+// LogValue implements slog.LogValuer, logging dt as its String or null if
+// either the date or time is not Valid.
+func (dt DateTime) LogValue() slog.Value {
+	return logValue(dt.String(), dt.Date.Valid && dt.Time.Valid)
+}
+
+// LogValue implements slog.LogValuer, logging odt as its String or null if
+// either the date or time is not Valid.
+func (odt OffsetDateTime) LogValue() slog.Value {
+	return logValue(odt.String(), odt.DateTime.Date.Valid && odt.DateTime.Time.Valid)
+}
+
+// LogValue implements slog.LogValuer, logging r as its String or null if
+// either end is not Valid.
+func (r DateRange) LogValue() slog.Value {
+	return logValue(r.String(), r.Start.Valid && r.End.Valid)
+}
+
+// LogValue implements slog.LogValuer, logging r as its String or null if
+// either end is not Valid.
+func (r DateTimeRange) LogValue() slog.Value {
+	return logValue(r.String(), r.String() != "")
+}
+
+// DateAttr returns an slog.Attr for a Date.
+func DateAttr(key string, d Date) slog.Attr { return slog.Attr{Key: key, Value: d.LogValue()} }
+
+// TimeAttr returns an slog.Attr for a Time.
+func TimeAttr(key string, t Time) slog.Attr { return slog.Attr{Key: key, Value: t.LogValue()} }
+
+// DateTimeAttr returns an slog.Attr for a DateTime.
+func DateTimeAttr(key string, dt DateTime) slog.Attr {
+	return slog.Attr{Key: key, Value: dt.LogValue()}
+}
+
+// OffsetDateTimeAttr returns an slog.Attr for an OffsetDateTime.
+func OffsetDateTimeAttr(key string, odt OffsetDateTime) slog.Attr {
+	return slog.Attr{Key: key, Value: odt.LogValue()}
+}
+
+// DateRangeAttr returns an slog.Attr for a DateRange.
+func DateRangeAttr(key string, r DateRange) slog.Attr {
+	return slog.Attr{Key: key, Value: r.LogValue()}
+}
+
+// DateTimeRangeAttr returns an slog.Attr for a DateTimeRange.
+func DateTimeRangeAttr(key string, r DateTimeRange) slog.Attr {
+	return slog.Attr{Key: key, Value: r.LogValue()}
+}
@@ -0,0 +1,44 @@
+package dt
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	d := Date{2024, time.May, 15, true}
+	tm := Time{18, 30, true}
+	logger.Info("",
+		"date", d,
+		"clock", tm,
+		"datetime", DateTime{d, tm},
+		"odt", OffsetDateTime{DateTime{d, tm}, 7200},
+		"range", DateRange{d, Date{2024, time.May, 31, true}},
+		"span", DateTimeRange{DateTime{d, tm}, DateTime{d, Time{20, 0, true}}},
+		"invalid", Date{},
+		DateAttr("d", d),
+		TimeAttr("t", Time{}),
+		DateTimeAttr("dt", DateTime{d, tm}),
+		OffsetDateTimeAttr("o", OffsetDateTime{}),
+		DateRangeAttr("r", DateRange{}),
+		DateTimeRangeAttr("s", DateTimeRange{Start: DateTime{d, tm}}),
+	)
+	want := `{"date":"2024-05-15","clock":"18:30","datetime":"2024-05-15T18:30","odt":"2024-05-15T18:30:00+02:00",` +
+		`"range":"2024-05-15/2024-05-31","span":"2024-05-15T18:30/2024-05-15T20:00","invalid":null,` +
+		`"d":"2024-05-15","t":null,"dt":"2024-05-15T18:30","o":null,"r":null,"s":null}`
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,219 @@
+package dt
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"time"
+)
+
+// A TimeSpan is the part of a day from Start up to, but not including, End.
+// A span lasting until midnight ends at Time{Hour: 24, Valid: true}, the only
+// End that is not a valid Time.
+type TimeSpan struct {
+	Start Time
+	End   Time
+}
+
+// endOfDay is the End of a TimeSpan lasting until midnight.
+var endOfDay = Time{Hour: 24, Valid: true}
+
+// IsValid reports whether Start is a valid Time, End is a valid Time or
+// 24:00, and Start is before End.
+func (s TimeSpan) IsValid() bool {
+	start, end := s.minutes()
+	return s.Start.IsValid() && (s.End.IsValid() || s.End == endOfDay) && start < end
+}
+
+// minutes returns the minutes of the day at which s starts and ends.
+func (s TimeSpan) minutes() (int, int) {
+	return s.Start.Hour*60 + s.Start.Minute, s.End.Hour*60 + s.End.Minute
+}
+
+// WorkingHours holds the spans of each day of the week during which someone
+// is available, indexed by time.Weekday. Days without spans are days off,
+// and spans that are not valid are ignored.
+type WorkingHours [7][]TimeSpan
+
+// NewWorkingHours returns working hours from start to end on the given days.
+func NewWorkingHours(start, end Time, days ...time.Weekday) WorkingHours {
+	var w WorkingHours
+	for _, d := range days {
+		w[d] = append(w[d], TimeSpan{start, end})
+	}
+	return w
+}
+
+// A SlotFinder finds free slots of time for a group of participants.
+// All times are wall-clock times in a single time zone.
+type SlotFinder struct {
+	// Hours lists the working hours of each participant. A slot must lie
+	// within the working hours of all of them. If empty, any time of any day
+	// may be used.
+	Hours []WorkingHours
+	// Busy lists the ranges during which any of the participants is busy.
+	// They may overlap and need not be sorted.
+	Busy []DateTimeRange
+	// Length is the duration of a slot, rounded down to whole minutes.
+	Length time.Duration
+	// Align is the spacing of slot start times, counted from midnight of each
+	// day, such as 30 minutes for slots starting on the hour and half hour.
+	// If Align does not divide a day, the last start time of a day is
+	// followed by midnight. Slots overlap if they start less than Length
+	// apart. If zero, Length is used.
+	Align time.Duration
+	// BufferBefore and BufferAfter are the free time required before and
+	// after a slot, for example to travel between meetings. Buffers only
+	// keep a slot away from busy ranges and may fall outside working hours.
+	BufferBefore time.Duration
+	BufferAfter  time.Duration
+}
+
+// span is a range of Unix minutes [start, end).
+type span struct{ start, end int }
+
+// Slots returns the free slots that lie within r, in ascending order.
+// The slots are computed as they are requested, so r may cover a long period.
+func (f SlotFinder) Slots(r DateTimeRange) iter.Seq[DateTimeRange] {
+	return func(yield func(DateTimeRange) bool) {
+		length := int(f.Length / time.Minute)
+		align := int(f.Align / time.Minute)
+		if align <= 0 {
+			align = length
+		}
+		if length <= 0 || r.check() != nil {
+			return
+		}
+		from, until := r.Start.unixMinutes(), r.End.unixMinutes()
+		busy := f.busy()
+		for free := range f.free(r.Start.Date, r.End.Date, busy) {
+			start := alignMinute(max(free.start, from), align)
+			for ; start+length <= min(free.end, until); start = alignMinute(start+1, align) {
+				slot := DateTimeRange{dateTimeFromUnixMinutes(start), dateTimeFromUnixMinutes(start + length)}
+				if !yield(slot) {
+					return
+				}
+			}
+			if free.end >= until {
+				return
+			}
+		}
+	}
+}
+
+// alignMinute returns the first Unix minute from m on that is a multiple of
+// align minutes after the midnight of its day.
+func alignMinute(m, align int) int {
+	midnight := floorDiv(m, minutesPerDay) * minutesPerDay
+	return min(m+floorMod(midnight-m, align), midnight+minutesPerDay)
+}
+
+// busy returns the busy ranges of f, widened by the buffers, as sorted
+// disjoint spans.
+func (f SlotFinder) busy() []span {
+	before, after := int(f.BufferBefore/time.Minute), int(f.BufferAfter/time.Minute)
+	spans := make([]span, 0, len(f.Busy))
+	for _, b := range f.Busy {
+		if b.IsValid() && !b.IsEmpty() {
+			// A slot needs BufferAfter minutes before the next busy range
+			// and BufferBefore minutes after the previous one.
+			spans = append(spans, span{b.Start.unixMinutes() - after, b.End.unixMinutes() + before})
+		}
+	}
+	slices.SortFunc(spans, func(a, b span) int { return cmp.Compare(a.start, b.start) })
+	return mergeSpans(spans)
+}
+
+// free returns the spans between the start of first and the end of last in
+// which all participants are working and none is busy. Spans that touch
+// across midnight are joined.
+func (f SlotFinder) free(first, last Date, busy []span) iter.Seq[span] {
+	return func(yield func(span) bool) {
+		pending := span{}
+		open := false
+		emit := func(s span) bool {
+			if open && pending.end == s.start {
+				pending.end = s.end
+				return true
+			}
+			if open && !yield(pending) {
+				return false
+			}
+			pending, open = s, true
+			return true
+		}
+		for d := first; !d.After(last); d = d.AddDays(1) {
+			midnight := d.UnixDays() * minutesPerDay
+			for _, w := range f.working(d.Weekday()) {
+				s := span{midnight + w.start, midnight + w.end}
+				// Drop busy spans that end before s, then cut the rest out of s.
+				for len(busy) > 0 && busy[0].end <= s.start {
+					busy = busy[1:]
+				}
+				for _, b := range busy {
+					if b.start >= s.end {
+						break
+					}
+					if b.start > s.start && !emit(span{s.start, b.start}) {
+						return
+					}
+					s.start = max(s.start, b.end)
+				}
+				if s.start < s.end && !emit(s) {
+					return
+				}
+			}
+		}
+		if open {
+			yield(pending)
+		}
+	}
+}
+
+// working returns the spans of the day, in minutes, during which all
+// participants are working.
+func (f SlotFinder) working(wd time.Weekday) []span {
+	common := []span{{0, minutesPerDay}}
+	for _, h := range f.Hours {
+		var spans []span
+		for _, ts := range h[wd] {
+			if ts.IsValid() {
+				start, end := ts.minutes()
+				spans = append(spans, span{start, end})
+			}
+		}
+		slices.SortFunc(spans, func(a, b span) int { return cmp.Compare(a.start, b.start) })
+		common = intersectSpans(common, mergeSpans(spans))
+	}
+	return common
+}
+
+// mergeSpans joins the overlapping and touching spans of a list sorted by start.
+func mergeSpans(spans []span) []span {
+	var merged []span
+	for _, s := range spans {
+		if n := len(merged); n > 0 && s.start <= merged[n-1].end {
+			merged[n-1].end = max(merged[n-1].end, s.end)
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// intersectSpans returns the spans covered by both a and b, which must be
+// sorted and disjoint.
+func intersectSpans(a, b []span) []span {
+	var r []span
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if s := (span{max(a[i].start, b[j].start), min(a[i].end, b[j].end)}); s.start < s.end {
+			r = append(r, s)
+		}
+		if a[i].end < b[j].end {
+			i++
+		} else {
+			j++
+		}
+	}
+	return r
+}
@@ -0,0 +1,159 @@
+package dt
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSlotFinder(t *testing.T) {
+	rng := func(s string) DateTimeRange {
+		t.Helper()
+		r, err := ParseDateTimeRange(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	office := NewWorkingHours(Time{9, 0, true}, Time{17, 0, true}, weekdays...)
+	split := WorkingHours{}
+	for _, wd := range weekdays {
+		split[wd] = []TimeSpan{{Time{13, 0, true}, Time{18, 0, true}}, {Time{8, 0, true}, Time{12, 0, true}}}
+	}
+	// 2024-05-17 is a Friday.
+	week := rng("2024-05-17T00:00/2024-05-24T00:00")
+
+	cases := []struct {
+		name  string
+		f     SlotFinder
+		r     DateTimeRange
+		limit int
+		want  []string
+	}{
+		{
+			name:  "Working hours",
+			f:     SlotFinder{Hours: []WorkingHours{office}, Length: 30 * time.Minute},
+			r:     rng("2024-05-17T15:50/2024-05-24T00:00"),
+			limit: 4,
+			want:  []string{"2024-05-17T16:00/2024-05-17T16:30", "2024-05-17T16:30/2024-05-17T17:00", "2024-05-20T09:00/2024-05-20T09:30", "2024-05-20T09:30/2024-05-20T10:00"},
+		},
+		{
+			name: "All participants free",
+			f: SlotFinder{
+				Hours:  []WorkingHours{office, split},
+				Busy:   []DateTimeRange{rng("2024-05-17T09:00/2024-05-17T10:15"), rng("2024-05-17T10:00/2024-05-17T11:00"), rng("2024-05-17T14:00/2024-05-17T16:40")},
+				Length: time.Hour,
+				Align:  30 * time.Minute,
+			},
+			r:     week,
+			limit: 3,
+			want:  []string{"2024-05-17T11:00/2024-05-17T12:00", "2024-05-17T13:00/2024-05-17T14:00", "2024-05-20T09:00/2024-05-20T10:00"},
+		},
+		{
+			name: "Buffers",
+			f: SlotFinder{
+				Hours:        []WorkingHours{office},
+				Busy:         []DateTimeRange{rng("2024-05-17T10:00/2024-05-17T11:00")},
+				Length:       30 * time.Minute,
+				Align:        15 * time.Minute,
+				BufferBefore: 15 * time.Minute,
+				BufferAfter:  10 * time.Minute,
+			},
+			r:     rng("2024-05-17T09:00/2024-05-17T12:00"),
+			limit: 10,
+			want:  []string{"2024-05-17T09:00/2024-05-17T09:30", "2024-05-17T09:15/2024-05-17T09:45", "2024-05-17T11:15/2024-05-17T11:45", "2024-05-17T11:30/2024-05-17T12:00"},
+		},
+		{
+			name:  "Across midnight without working hours",
+			f:     SlotFinder{Busy: []DateTimeRange{rng("2024-05-17T00:00/2024-05-17T22:00")}, Length: 3 * time.Hour, Align: time.Hour},
+			r:     week,
+			limit: 2,
+			want:  []string{"2024-05-17T22:00/2024-05-18T01:00", "2024-05-17T23:00/2024-05-18T02:00"},
+		},
+		{
+			name:  "Align not dividing a day",
+			f:     SlotFinder{Length: 30 * time.Minute, Align: 25 * time.Minute},
+			r:     rng("2024-05-17T23:00/2024-05-18T01:00"),
+			limit: 10,
+			want: []string{
+				"2024-05-17T23:20/2024-05-17T23:50", "2024-05-17T23:45/2024-05-18T00:15",
+				"2024-05-18T00:00/2024-05-18T00:30", "2024-05-18T00:25/2024-05-18T00:55",
+			},
+		},
+		{
+			name: "Invalid spans ignored",
+			f: SlotFinder{
+				Hours:  []WorkingHours{NewWorkingHours(Time{22, 0, true}, Time{24, 30, true}, time.Friday)},
+				Length: time.Hour,
+			},
+			r:     week,
+			limit: 1,
+		},
+		{
+			name:  "No common hours",
+			f:     SlotFinder{Hours: []WorkingHours{office, NewWorkingHours(Time{17, 0, true}, Time{24, 0, true}, weekdays...)}, Length: time.Minute},
+			r:     week,
+			limit: 1,
+		},
+		{
+			name:  "Zero length",
+			f:     SlotFinder{Hours: []WorkingHours{office}},
+			r:     week,
+			limit: 1,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for slot := range tt.f.Slots(tt.r) {
+				got = append(got, slot.String())
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeSpanIsValid(t *testing.T) {
+	for _, tt := range []struct {
+		s    TimeSpan
+		want bool
+	}{
+		{TimeSpan{Time{9, 0, true}, Time{17, 0, true}}, true},
+		{TimeSpan{Time{17, 0, true}, Time{24, 0, true}}, true},
+		{TimeSpan{Time{0, 0, true}, Time{0, 0, true}}, false},
+		{TimeSpan{Time{17, 0, true}, Time{9, 0, true}}, false},
+		{TimeSpan{Time{17, 0, true}, Time{24, 1, true}}, false},
+		{TimeSpan{Time{24, 0, true}, Time{24, 0, true}}, false},
+		{TimeSpan{Time{9, 0, true}, Time{17, 0, false}}, false},
+		{TimeSpan{Time{9, 0, true}, Time{24, 0, false}}, false},
+	} {
+		if got := tt.s.IsValid(); got != tt.want {
+			t.Errorf("%v.IsValid(): got %t, want %t", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestSlotFinderFillsRange(t *testing.T) {
+	f := SlotFinder{
+		Hours:  []WorkingHours{NewWorkingHours(Time{9, 0, true}, Time{12, 0, true}, time.Monday, time.Wednesday)},
+		Length: time.Hour,
+	}
+	r := DateTimeRange{DateTime{Date{2024, 1, 1, true}, Time{0, 0, true}}, DateTime{Date{2025, 1, 1, true}, Time{0, 0, true}}}
+	n := 0
+	for slot := range f.Slots(r) {
+		if wd := slot.Start.Date.Weekday(); wd != time.Monday && wd != time.Wednesday {
+			t.Fatalf("slot %v on %v", slot, wd)
+		}
+		n++
+	}
+	// 2024 has 53 Mondays and 52 Wednesdays.
+	if n != 105*3 {
+		t.Errorf("expected %d slots, got %d", 105*3, n)
+	}
+}
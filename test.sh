@@ -3,10 +3,18 @@
 set -e
 echo "" > coverage.txt
 
-for d in $(go list ./...); do
-    go test -race -coverprofile=profile.out -covermode=atomic "$d"
-    if [ -f profile.out ]; then
-        cat profile.out >> coverage.txt
-        rm profile.out
-    fi
-done
\ No newline at end of file
+# Adapter packages such as dtpb are separate modules, so that the core
+# package does not depend on their libraries. They are tested against this
+# checkout rather than the dt release they require.
+root=$(pwd)
+export GOWORK="$root/dev.work"
+for mod in $(find . -name go.mod -exec dirname {} \;); do
+    cd "$root/$mod"
+    for d in $(go list ./...); do
+        go test -race -coverprofile=profile.out -covermode=atomic "$d"
+        if [ -f profile.out ]; then
+            cat profile.out >> "$root/coverage.txt"
+            rm profile.out
+        fi
+    done
+done
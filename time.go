@@ -42,16 +42,26 @@ func TimeOf(t time.Time) Time {
 	return tm
 }
 
+// NewTime returns the Time for the given hour and minute.
+// It returns a *RangeError if either value is out of range.
+func NewTime(hour, minute int) (Time, error) {
+	t := Time{Hour: hour, Minute: minute, Valid: true}
+	if err := t.check(); err != nil {
+		return Time{}, err
+	}
+	return t, nil
+}
+
 // ParseTime parses a string and returns the time value it represents.
 // ParseTime accepts an extended form of the RFC3339 partial-time format. After
 // the HH:MM:SS part of the string, an optional fractional part may appear,
 // consisting of a decimal point followed by one to nine decimal digits.
 // (RFC3339 admits only one digit after the decimal point).
+// Failures are reported as a *ParseError.
 func ParseTime(s string) (Time, error) {
-	t, err := time.Parse("15:04", s)
+	t, err := parseLayouts("time", s, "15:04", "15:04:05")
 	if err != nil {
-		t, err := time.Parse("15:04:05", s)
-		return TimeOf(t), err
+		return Time{}, err
 	}
 	return TimeOf(t), nil
 }
@@ -65,6 +75,43 @@ func (t Time) String() string {
 	return ""
 }
 
+// IsValid reports whether t is Valid and its fields are within range.
+func (t Time) IsValid() bool {
+	return t.Valid && t.check() == nil
+}
+
+// Normalize returns t with minutes carried over into hours, and hours
+// wrapped around midnight. For example, 23:75 normalizes to 00:15.
+func (t Time) Normalize() Time {
+	_, n := t.normalize()
+	return n
+}
+
+// normalize returns t's overflow in whole days along with the normalized time.
+func (t Time) normalize() (int, Time) {
+	mins := t.Hour*60 + t.Minute
+	days := mins / minutesPerDay
+	mins %= minutesPerDay
+	if mins < 0 {
+		mins += minutesPerDay
+		days--
+	}
+	return days, Time{Hour: mins / 60, Minute: mins % 60, Valid: t.Valid}
+}
+
+const minutesPerDay = 24 * 60
+
+// check returns a *RangeError describing the first out-of-range field of t.
+func (t Time) check() error {
+	if t.Hour < 0 || t.Hour > 23 {
+		return &RangeError{Field: "hour", Value: t.Hour}
+	}
+	if t.Minute < 0 || t.Minute > 59 {
+		return &RangeError{Field: "minute", Value: t.Minute}
+	}
+	return nil
+}
+
 // ToDate converts Time into time.Time
 func (t Time) ToDate() time.Time {
 	return time.Date(0, 0, 0, t.Hour, t.Minute, 0, 0, time.UTC)
@@ -92,8 +139,14 @@ func (t Time) Subtract(t2 Time) int {
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
-// The output is the result of d.String().
+// The output is the result of t.String(). A Valid time holding an
+// out-of-range field results in a *RangeError.
 func (t Time) MarshalText() ([]byte, error) {
+	if t.Valid {
+		if err := t.check(); err != nil {
+			return nil, err
+		}
+	}
 	return []byte(t.String()), nil
 }
 
@@ -108,6 +161,9 @@ func (t *Time) UnmarshalText(data []byte) error {
 // Value implements valuer interface
 func (t Time) Value() (driver.Value, error) {
 	if t.Valid {
+		if err := t.check(); err != nil {
+			return nil, err
+		}
 		return driver.Value(t.String()), nil
 	}
 	return nil, nil
@@ -2,6 +2,7 @@ package dt
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 )
@@ -353,3 +354,88 @@ func TestTimeCompare(t *testing.T) {
 		}
 	}
 }
+
+func TestNewTime(t *testing.T) {
+	cases := []struct {
+		name         string
+		hour, minute int
+		want         Time
+		wantField    string
+	}{
+		{
+			name:   "Valid time",
+			hour:   23,
+			minute: 59,
+			want:   Time{23, 59, true},
+		},
+		{
+			name:      "Hour out of range",
+			hour:      24,
+			wantField: "hour",
+		},
+		{
+			name:      "Minute out of range",
+			hour:      12,
+			minute:    -1,
+			wantField: "minute",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTime(tt.hour, tt.minute)
+			var rerr *RangeError
+			if errors.As(err, &rerr) != (tt.wantField != "") {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rerr != nil && rerr.Field != tt.wantField {
+				t.Errorf("expected field %s, got %s", tt.wantField, rerr.Field)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeIsValid(t *testing.T) {
+	for _, tt := range []struct {
+		t    Time
+		want bool
+	}{
+		{Time{0, 0, true}, true},
+		{Time{23, 59, true}, true},
+		{Time{12, 0, false}, false},
+		{Time{25, 0, true}, false},
+		{Time{12, 60, true}, false},
+	} {
+		if got := tt.t.IsValid(); got != tt.want {
+			t.Errorf("%#v.IsValid(): got %t, want %t", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestTimeNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		t, want Time
+	}{
+		{Time{23, 75, true}, Time{0, 15, true}},
+		{Time{25, 0, true}, Time{1, 0, true}},
+		{Time{0, -30, true}, Time{23, 30, true}},
+		{Time{10, 30, true}, Time{10, 30, true}},
+	} {
+		if got := tt.t.Normalize(); got != tt.want {
+			t.Errorf("%#v.Normalize(): got %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestTimeRejectOutOfRange(t *testing.T) {
+	tm := Time{25, 0, true}
+	var rerr *RangeError
+	if _, err := tm.Value(); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from Value, got %v", err)
+	}
+	if _, err := tm.MarshalText(); !errors.As(err, &rerr) {
+		t.Errorf("expected RangeError from MarshalText, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package dt
+
+// The TOML methods below follow the Marshaler and Unmarshaler interfaces of
+// github.com/BurntSushi/toml. Other TOML libraries fall back to MarshalText
+// and UnmarshalText.
+
+// MarshalTOML implements the toml.Marshaler interface.
+// Valid dates are written as native TOML local dates, e.g. 1979-05-27.
+// TOML has no null, so dates that are not Valid are written as an empty string.
+func (d Date) MarshalTOML() ([]byte, error) {
+	if !d.Valid {
+		return []byte(`""`), nil
+	}
+	if err := d.check(); err != nil {
+		return nil, err
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface.
+// It accepts native local dates and datetimes, and strings in a format accepted by ParseDate.
+// An empty string results in a Date that is not Valid.
+func (d *Date) UnmarshalTOML(v interface{}) error {
+	if v == "" {
+		v = nil
+	}
+	return d.decodeNative(v)
+}
+
+// MarshalTOML implements the toml.Marshaler interface.
+// Valid times are written as native TOML local times, e.g. 07:32:00.
+// TOML has no null, so times that are not Valid are written as an empty string.
+func (t Time) MarshalTOML() ([]byte, error) {
+	if !t.Valid {
+		return []byte(`""`), nil
+	}
+	if err := t.check(); err != nil {
+		return nil, err
+	}
+	return []byte(t.String() + ":00"), nil
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface.
+// It accepts native local times, and strings in a format accepted by ParseTime.
+// An empty string results in a Time that is not Valid.
+func (t *Time) UnmarshalTOML(v interface{}) error {
+	if v == "" {
+		v = nil
+	}
+	return t.decodeNative(v)
+}
+
+// MarshalTOML implements the toml.Marshaler interface.
+// Valid datetimes are written as native TOML local datetimes, e.g. 1979-05-27T07:32:00.
+// TOML has no null, so datetimes that are not Valid are written as an empty string.
+func (dt DateTime) MarshalTOML() ([]byte, error) {
+	if err := dt.check(); err != nil {
+		return nil, err
+	}
+	if !dt.Date.Valid || !dt.Time.Valid {
+		return []byte(`""`), nil
+	}
+	return []byte(dt.String() + ":00"), nil
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface.
+// It accepts native local and offset datetimes, whose offset is dropped, and
+// strings in a format accepted by ParseDateTime.
+// An empty string results in a DateTime that is not Valid.
+func (dt *DateTime) UnmarshalTOML(v interface{}) error {
+	if v == "" {
+		v = nil
+	}
+	return dt.decodeNative(v)
+}
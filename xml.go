@@ -0,0 +1,289 @@
+package dt
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The XML methods below follow the lexical spaces of the XML Schema types
+// xs:date, xs:time and xs:dateTime. Date, Time and DateTime accept an optional
+// zone designator, such as "Z" or "+02:00", and strip it, keeping the value as
+// written. To preserve the zone, use XMLZoned, or OffsetDateTime for an
+// xs:dateTime that always has one.
+//
+// Values that are not Valid are omitted when marshaling, and empty elements
+// or attributes unmarshal into values that are not Valid.
+
+// MarshalXML implements the xml.Marshaler interface, encoding d as an xs:date.
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, d)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, decoding an xs:date.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(dec, start, d)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, encoding d as an xs:date.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, decoding an xs:date.
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.unmarshalXSD(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface, encoding t as an xs:time.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, t)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, decoding an xs:time.
+// Seconds and fractional seconds are discarded.
+func (t *Time) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(dec, start, t)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, encoding t as an xs:time.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, decoding an xs:time.
+// Seconds and fractional seconds are discarded.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.unmarshalXSD(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface, encoding dt as an xs:dateTime.
+func (dt DateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, dt)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, decoding an xs:dateTime.
+// Seconds and fractional seconds are discarded.
+func (dt *DateTime) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(dec, start, dt)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, encoding dt as an xs:dateTime.
+func (dt DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, dt)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, decoding an xs:dateTime.
+// Seconds and fractional seconds are discarded.
+func (dt *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dt.unmarshalXSD(attr.Value)
+}
+
+// MarshalXML implements the xml.Marshaler interface, encoding odt as an
+// xs:dateTime with its zone designator.
+func (odt OffsetDateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, odt)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, decoding an
+// xs:dateTime that has a zone designator.
+func (odt *OffsetDateTime) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(dec, start, odt)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, encoding odt as
+// an xs:dateTime with its zone designator.
+func (odt OffsetDateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, odt)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, decoding an
+// xs:dateTime that has a zone designator.
+func (odt *OffsetDateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return odt.unmarshalXSD(attr.Value)
+}
+
+// An XMLZoned holds a Date, Time or DateTime along with the optional zone
+// designator of its XML form, so that a value such as 2024-01-01+02:00
+// keeps its zone when it is unmarshaled and marshaled again.
+type XMLZoned[T Date | Time | DateTime] struct {
+	Value T
+	// Zone is the offset of the zone designator in seconds east of UTC,
+	// 0 for "Z". It is only used if HasZone is set.
+	Zone    int
+	HasZone bool
+}
+
+// MarshalXML implements the xml.Marshaler interface, encoding z with its zone designator.
+func (z XMLZoned[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, z)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, keeping the zone designator if any.
+func (z *XMLZoned[T]) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(dec, start, z)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, encoding z with its zone designator.
+func (z XMLZoned[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, z)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, keeping the zone designator if any.
+func (z *XMLZoned[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return z.unmarshalXSD(attr.Value)
+}
+
+func (z XMLZoned[T]) marshalXSD() (string, error) {
+	s, err := any(z.Value).(xsdMarshaler).marshalXSD()
+	if s == "" || err != nil || !z.HasZone {
+		return s, err
+	}
+	return s + formatXSDZone(z.Zone), nil
+}
+
+func (z *XMLZoned[T]) unmarshalXSD(s string) error {
+	s, zone, ok, err := splitXSDZone(s)
+	if err != nil {
+		return err
+	}
+	*z = XMLZoned[T]{}
+	if s == "" {
+		return nil
+	}
+	if err := any(&z.Value).(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+		return err
+	}
+	z.Zone, z.HasZone = zone, ok
+	return nil
+}
+
+type xsdMarshaler interface {
+	marshalXSD() (string, error)
+}
+
+type xsdUnmarshaler interface {
+	unmarshalXSD(s string) error
+}
+
+func marshalXMLElement(e *xml.Encoder, start xml.StartElement, v xsdMarshaler) error {
+	s, err := v.marshalXSD()
+	if s == "" || err != nil {
+		return err
+	}
+	return e.EncodeElement(s, start)
+}
+
+func unmarshalXMLElement(dec *xml.Decoder, start xml.StartElement, v xsdUnmarshaler) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return v.unmarshalXSD(s)
+}
+
+func marshalXMLAttr(name xml.Name, v xsdMarshaler) (xml.Attr, error) {
+	s, err := v.marshalXSD()
+	if s == "" || err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: s}, nil
+}
+
+// splitXSDZone trims whitespace around s and splits off its zone designator,
+// if any, returning its offset in seconds east of UTC.
+func splitXSDZone(s string) (value string, offset int, ok bool, err error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "Z") {
+		return s[:len(s)-1], 0, true, nil
+	}
+	n := len(s)
+	if n <= 6 || (s[n-6] != '+' && s[n-6] != '-') || s[n-3] != ':' {
+		return s, 0, false, nil
+	}
+	h, herr := strconv.ParseUint(s[n-5:n-3], 10, 8)
+	m, merr := strconv.ParseUint(s[n-2:], 10, 8)
+	if herr != nil || merr != nil || m > 59 || h*60+m > 14*60 {
+		return "", 0, false, &ParseError{Type: "zone designator", Input: s, Expected: []string{"Z", "±hh:mm"}, Offset: n - 6, Field: "offset"}
+	}
+	offset = int(h*3600 + m*60)
+	if s[n-6] == '-' {
+		offset = -offset
+	}
+	return s[:n-6], offset, true, nil
+}
+
+// formatXSDZone returns the zone designator of offset seconds east of UTC.
+func formatXSDZone(offset int) string {
+	if offset == 0 {
+		return "Z"
+	}
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+}
+
+func (d Date) marshalXSD() (string, error) {
+	b, err := d.MarshalText()
+	return string(b), err
+}
+
+func (d *Date) unmarshalXSD(s string) error {
+	s, _, _, err := splitXSDZone(s)
+	if s == "" || err != nil {
+		*d = Date{}
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+func (t Time) marshalXSD() (string, error) {
+	b, err := t.MarshalText()
+	if len(b) == 0 || err != nil {
+		return "", err
+	}
+	return string(b) + ":00", nil
+}
+
+func (t *Time) unmarshalXSD(s string) error {
+	s, _, _, err := splitXSDZone(s)
+	if s == "" || err != nil {
+		*t = Time{}
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+func (dt DateTime) marshalXSD() (string, error) {
+	b, err := dt.MarshalText()
+	if len(b) == 0 || err != nil {
+		return "", err
+	}
+	return string(b) + ":00", nil
+}
+
+func (dt *DateTime) unmarshalXSD(s string) error {
+	s, _, _, err := splitXSDZone(s)
+	if s == "" || err != nil {
+		*dt = DateTime{}
+		return err
+	}
+	return dt.UnmarshalText([]byte(s))
+}
+
+func (odt OffsetDateTime) marshalXSD() (string, error) {
+	b, err := odt.MarshalText()
+	return string(b), err
+}
+
+func (odt *OffsetDateTime) unmarshalXSD(s string) error {
+	if s = strings.TrimSpace(s); s == "" {
+		*odt = OffsetDateTime{}
+		return nil
+	}
+	return odt.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,231 @@
+package dt
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlElements struct {
+	XMLName xml.Name       `xml:"event"`
+	D       Date           `xml:"date"`
+	T       Time           `xml:"time"`
+	DT      DateTime       `xml:"start"`
+	ODT     OffsetDateTime `xml:"created"`
+}
+
+type xmlAttrs struct {
+	XMLName xml.Name       `xml:"event"`
+	D       Date           `xml:"date,attr"`
+	T       Time           `xml:"time,attr"`
+	DT      DateTime       `xml:"start,attr"`
+	ODT     OffsetDateTime `xml:"created,attr"`
+}
+
+func TestMarshalXML(t *testing.T) {
+	d := Date{2024, 1, 1, true}
+	tm := Time{10, 30, true}
+	dt := DateTime{d, tm}
+	odt := OffsetDateTime{dt, 7200}
+	cases := []struct {
+		name string
+		req  interface{}
+		want string
+	}{
+		{
+			name: "Elements",
+			req:  xmlElements{D: d, T: tm, DT: dt, ODT: odt},
+			want: `<event><date>2024-01-01</date><time>10:30:00</time><start>2024-01-01T10:30:00</start><created>2024-01-01T10:30:00+02:00</created></event>`,
+		},
+		{
+			name: "Invalid elements",
+			req:  xmlElements{},
+			want: `<event></event>`,
+		},
+		{
+			name: "Attributes",
+			req:  xmlAttrs{D: d, T: tm, DT: dt, ODT: odt},
+			want: `<event date="2024-01-01" time="10:30:00" start="2024-01-01T10:30:00" created="2024-01-01T10:30:00+02:00"></event>`,
+		},
+		{
+			name: "Invalid attributes",
+			req:  xmlAttrs{},
+			want: `<event></event>`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xml.Marshal(tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	d := Date{2024, 1, 1, true}
+	tm := Time{10, 30, true}
+	dt := DateTime{d, tm}
+	cases := []struct {
+		name    string
+		req     string
+		want    xmlElements
+		wantErr bool
+	}{
+		{
+			name: "Without zones",
+			req:  `<event><date>2024-01-01</date><time>10:30:15.5</time><start>2024-01-01T10:30:00</start><created>2024-01-01T10:30:00Z</created></event>`,
+			want: xmlElements{D: d, T: tm, DT: dt, ODT: OffsetDateTime{dt, 0}},
+		},
+		{
+			name: "With zones",
+			req:  `<event><date>2024-01-01Z</date><time>10:30:00-05:00</time><start> 2024-01-01T10:30:00+02:00 </start><created>2024-01-01T10:30:00+02:00</created></event>`,
+			want: xmlElements{D: d, T: tm, DT: dt, ODT: OffsetDateTime{dt, 7200}},
+		},
+		{
+			name: "Empty elements",
+			req:  `<event><date></date><time/><start/></event>`,
+		},
+		{
+			name:    "Missing zone in OffsetDateTime",
+			req:     `<event><created>2024-01-01T10:30:00</created></event>`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid date",
+			req:     `<event><date>2024-02-30+01:00</date></event>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var got xmlElements
+			err := xml.Unmarshal([]byte(tt.req), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got.XMLName = xml.Name{}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalXMLAttr(t *testing.T) {
+	d := Date{2024, 1, 1, true}
+	tm := Time{10, 30, true}
+	dt := DateTime{d, tm}
+	req := `<event date="2024-01-01+02:00" time="10:30:00Z" start="2024-01-01T10:30:00-03:00" created="2024-01-01T10:30:00-03:00"></event>`
+	want := xmlAttrs{D: d, T: tm, DT: dt, ODT: OffsetDateTime{dt, -3 * 3600}}
+
+	var got xmlAttrs
+	if err := xml.Unmarshal([]byte(req), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got.XMLName = xml.Name{}
+	if got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+type xmlZoned struct {
+	XMLName xml.Name           `xml:"event"`
+	D       XMLZoned[Date]     `xml:"date,attr"`
+	T       XMLZoned[Time]     `xml:"time"`
+	DT      XMLZoned[DateTime] `xml:"start"`
+}
+
+func TestXMLZones(t *testing.T) {
+	d := Date{2024, 1, 1, true}
+	tm := Time{10, 30, true}
+	dt := DateTime{d, tm}
+	cases := []struct {
+		name     string
+		req      string
+		want     xmlZoned
+		stripped string
+	}{
+		{
+			name:     "Offsets",
+			req:      `<event date="2024-01-01+02:00"><time>10:30:00-05:30</time><start>2024-01-01T10:30:00+14:00</start></event>`,
+			want:     xmlZoned{D: XMLZoned[Date]{d, 7200, true}, T: XMLZoned[Time]{tm, -5*3600 - 1800, true}, DT: XMLZoned[DateTime]{dt, 14 * 3600, true}},
+			stripped: `<event date="2024-01-01"><time>10:30:00</time><start>2024-01-01T10:30:00</start></event>`,
+		},
+		{
+			name:     "UTC",
+			req:      `<event date="2024-01-01Z"><time>10:30:00Z</time><start>2024-01-01T10:30:00Z</start></event>`,
+			want:     xmlZoned{D: XMLZoned[Date]{d, 0, true}, T: XMLZoned[Time]{tm, 0, true}, DT: XMLZoned[DateTime]{dt, 0, true}},
+			stripped: `<event date="2024-01-01"><time>10:30:00</time><start>2024-01-01T10:30:00</start></event>`,
+		},
+		{
+			name:     "Without zones",
+			req:      `<event date="2024-01-01"><time>10:30:00</time><start>2024-01-01T10:30:00</start></event>`,
+			want:     xmlZoned{D: XMLZoned[Date]{Value: d}, T: XMLZoned[Time]{Value: tm}, DT: XMLZoned[DateTime]{Value: dt}},
+			stripped: `<event date="2024-01-01"><time>10:30:00</time><start>2024-01-01T10:30:00</start></event>`,
+		},
+		{
+			name:     "Empty",
+			req:      `<event></event>`,
+			stripped: `<event></event>`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name+"/preserved", func(t *testing.T) {
+			var got xmlZoned
+			if err := xml.Unmarshal([]byte(tt.req), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got.XMLName = xml.Name{}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			b, err := xml.Marshal(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(b) != tt.req {
+				t.Errorf("expected %s, got %s", tt.req, b)
+			}
+		})
+		t.Run(tt.name+"/stripped", func(t *testing.T) {
+			var got struct {
+				XMLName xml.Name `xml:"event"`
+				D       Date     `xml:"date,attr"`
+				T       Time     `xml:"time"`
+				DT      DateTime `xml:"start"`
+			}
+			if err := xml.Unmarshal([]byte(tt.req), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.D != tt.want.D.Value || got.T != tt.want.T.Value || got.DT != tt.want.DT.Value {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			b, err := xml.Marshal(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(b) != tt.stripped {
+				t.Errorf("expected %s, got %s", tt.stripped, b)
+			}
+		})
+	}
+
+	for _, req := range []string{
+		`<event date="2024-01-01+15:00"></event>`,
+		`<event><time>10:30:00+02:60</time></event>`,
+		`<event><start>2024-01-01T10:30:00+ab:00</start></event>`,
+	} {
+		var zoned xmlZoned
+		if err := xml.Unmarshal([]byte(req), &zoned); err == nil {
+			t.Errorf("%s: expected error", req)
+		}
+	}
+	var plain xmlElements
+	if err := xml.Unmarshal([]byte(`<event><start>2024-01-01T10:30:00+ab:00</start></event>`), &plain); err == nil {
+		t.Error("expected error for invalid zone of a plain DateTime")
+	}
+}
@@ -0,0 +1,124 @@
+package dt
+
+import (
+	"fmt"
+	"time"
+)
+
+// The YAML methods below follow the function-based Marshaler and Unmarshaler
+// interfaces, which gopkg.in/yaml.v2 and gopkg.in/yaml.v3 both support, so the
+// package does not depend on either of them.
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The output is the result of d.String(), or null if d is not Valid.
+func (d Date) MarshalYAML() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	if err := d.check(); err != nil {
+		return nil, err
+	}
+	return d.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// It accepts native timestamps, strings in a format accepted by ParseDate, and null.
+func (d *Date) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return d.decodeNative(v)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The output is the result of t.String(), or null if t is not Valid.
+func (t Time) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	if err := t.check(); err != nil {
+		return nil, err
+	}
+	return t.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// It accepts strings in a format accepted by ParseTime, and null.
+func (t *Time) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return t.decodeNative(v)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The output is the result of dt.String(), or null if dt is not Valid.
+func (dt DateTime) MarshalYAML() (interface{}, error) {
+	if err := dt.check(); err != nil {
+		return nil, err
+	}
+	if !dt.Date.Valid || !dt.Time.Valid {
+		return nil, nil
+	}
+	return dt.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// It accepts native timestamps, whose offset is dropped, strings in a format
+// accepted by ParseDateTime, and null.
+func (dt *DateTime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return dt.decodeNative(v)
+}
+
+// decodeNative sets d from a value decoded by a YAML or TOML library.
+func (d *Date) decodeNative(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = Date{Year: v.Year(), Month: v.Month(), Day: v.Day(), Valid: true}
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	}
+	return fmt.Errorf("Can't convert %T to Date", v)
+}
+
+// decodeNative sets t from a value decoded by a YAML or TOML library.
+func (t *Time) decodeNative(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		*t = Time{}
+		return nil
+	case time.Time:
+		*t = Time{Hour: v.Hour(), Minute: v.Minute(), Valid: true}
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(v))
+	}
+	return fmt.Errorf("Can't convert %T to Time", v)
+}
+
+// decodeNative sets dt from a value decoded by a YAML or TOML library.
+func (dt *DateTime) decodeNative(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		*dt = DateTime{}
+		return nil
+	case time.Time:
+		if err := dt.Date.decodeNative(v); err != nil {
+			return err
+		}
+		return dt.Time.decodeNative(v)
+	case string:
+		return dt.UnmarshalText([]byte(v))
+	}
+	return fmt.Errorf("Can't convert %T to DateTime", v)
+}